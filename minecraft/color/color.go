@@ -128,16 +128,29 @@ func (c *RGB) Distance(c2 *RGB) float64 {
 	return colorful.Color(*c).DistanceRgb(colorful.Color(*c2))
 }
 
+// Blend returns the color linearly interpolated in RGB space between c (t=0) and c2 (t=1).
+func (c *RGB) Blend(c2 *RGB, t float64) *RGB {
+	col := colorful.Color(*c).BlendRgb(colorful.Color(*c2), t).Clamped()
+	return (*RGB)(&col)
+}
+
+// Hsv returns the color of the hue h in [0..360], saturation s and value v in [0..1].
+func Hsv(h, s, v float64) *RGB {
+	col := colorful.Hsv(h, s, v).Clamped()
+	return (*RGB)(&col)
+}
+
 // RGBA makes RGB implement the Go color.RGB interface.
 func (c *RGB) RGBA() (r uint32, g uint32, b uint32, a uint32) {
 	return colorful.Color(*c).RGBA()
 }
 
 // NearestNamed finds the nearest Named color for to this RGB.
+// Of equally near colors the first in NamesOrder is returned.
 func (c *RGB) NearestNamed() *Named {
 	matchedDistance := math.MaxFloat64
 	match := Black
-	for _, potential := range Names {
+	for _, potential := range NamesOrder {
 		if potential.RGB == c {
 			return potential
 		}
@@ -238,3 +251,35 @@ func MustHex(hex string) *RGB {
 	}
 	return c
 }
+
+// ARGB is a packed 32-bit color containing, in order, alpha, red, green and blue bytes.
+// It is used for text shadow colors.
+type ARGB uint32
+
+// MakeARGB returns the ARGB color of the given color with the alpha value.
+func MakeARGB(c color.Color, alpha uint8) ARGB {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return ARGB(uint32(alpha)<<24 | uint32(n.R)<<16 | uint32(n.G)<<8 | uint32(n.B))
+}
+
+// A returns the alpha byte of the color.
+func (c ARGB) A() uint8 { return uint8(c >> 24) }
+
+// R returns the red byte of the color.
+func (c ARGB) R() uint8 { return uint8(c >> 16) }
+
+// G returns the green byte of the color.
+func (c ARGB) G() uint8 { return uint8(c >> 8) }
+
+// B returns the blue byte of the color.
+func (c ARGB) B() uint8 { return uint8(c) }
+
+// RGB returns the color without its alpha value.
+func (c ARGB) RGB() *RGB {
+	return HexInt(int(c & 0xffffff))
+}
+
+// String returns the hex representation of the color, as in #80ff0080 (alpha first).
+func (c ARGB) String() string {
+	return fmt.Sprintf("#%08x", uint32(c))
+}
@@ -24,4 +24,30 @@ func TestHex(t *testing.T) {
 func TestNearest(t *testing.T) {
 	nearGold := HexInt(0xffaa01)
 	require.Equal(t, goldRGB, nearGold.NearestNamed().RGB)
+
+	// equally near to dark purple and gray, the first in NamesOrder wins
+	tie := HexInt(0xaa55aa)
+	require.Equal(t, tie.Distance(DarkPurple.RGB), tie.Distance(Gray.RGB))
+	for i := 0; i < 100; i++ {
+		require.Equal(t, DarkPurple, tie.NearestNamed())
+	}
+}
+
+func TestARGB(t *testing.T) {
+	c := MakeARGB(HexInt(0xffaa00), 0x80)
+	require.Equal(t, ARGB(0x80ffaa00), c)
+	require.Equal(t, uint8(0x80), c.A())
+	require.Equal(t, uint8(0xff), c.R())
+	require.Equal(t, uint8(0xaa), c.G())
+	require.Equal(t, uint8(0x00), c.B())
+	require.Equal(t, "#ffaa00", c.RGB().Hex())
+	require.Equal(t, "#80ffaa00", c.String())
+}
+
+func TestBlend(t *testing.T) {
+	red, blue := HexInt(0xff0000), HexInt(0x0000ff)
+	require.Equal(t, "#ff0000", red.Blend(blue, 0).Hex())
+	require.Equal(t, "#800080", red.Blend(blue, 0.5).Hex())
+	require.Equal(t, "#0000ff", red.Blend(blue, 1).Hex())
+	require.Equal(t, "#00ff00", Hsv(120, 1, 1).Hex())
 }
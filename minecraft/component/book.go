@@ -0,0 +1,119 @@
+package component
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// BookPageWidth is the width of a written book page in pixels.
+	BookPageWidth = 114
+	// BookPageLines is the number of lines of a written book page.
+	BookPageLines = 14
+	// PageBreak in the rendered text forces a page break when paginating.
+	PageBreak = '\f'
+)
+
+// Paginator lays out components into written book pages.
+//
+// The text is wrapped into lines like a Wrapper does and the lines are
+// laid out into pages. A PageBreak starts a new page.
+type Paginator struct {
+	// Width is the width of a page in pixels, zero uses BookPageWidth.
+	Width int
+	// Lines is the number of lines of a page, zero uses BookPageLines.
+	Lines int
+	// Footer optionally returns the last line of each page, e.g. navigation links
+	// created with PageLink. Page numbers start at 1.
+	Footer func(page, pages int) Component
+	// The optional Flattener to render components with.
+	Flattener *Flattener
+}
+
+// Paginate lays out the component tree rooted at c into pages.
+// Every page is a standalone component whose texts carry their effective style
+// relative to c.
+func (p *Paginator) Paginate(c Component) ([]Component, error) {
+	f := p.Flattener
+	if f == nil {
+		f = &Flattener{}
+	}
+	runs, err := f.Runs(c)
+	if err != nil {
+		return nil, err
+	}
+	width, lines := p.Width, p.Lines
+	if width <= 0 {
+		width = BookPageWidth
+	}
+	if lines <= 0 {
+		lines = BookPageLines
+	}
+	if p.Footer != nil && lines > 1 {
+		lines-- // reserve the last line
+	}
+
+	w := &Wrapper{MaxWidth: width}
+	var pages [][]line
+	for _, section := range splitRuns(runs, PageBreak) {
+		wrapped := w.wrap(section)
+		for len(wrapped) > lines {
+			pages = append(pages, wrapped[:lines])
+			wrapped = wrapped[lines:]
+		}
+		pages = append(pages, wrapped)
+	}
+
+	cs := make([]Component, len(pages))
+	for i, page := range pages {
+		extra := make([]Component, 0, 2*len(page)+1)
+		for j, l := range page {
+			if j != 0 {
+				extra = append(extra, &Text{Content: "\n"})
+			}
+			extra = append(extra, l.component())
+		}
+		if p.Footer != nil {
+			if footer := p.Footer(i+1, len(pages)); footer != nil {
+				for j := len(page); j <= lines; j++ {
+					extra = append(extra, &Text{Content: "\n"})
+				}
+				extra = append(extra, footer)
+			}
+		}
+		cs[i] = &Text{Extra: extra}
+	}
+	return cs, nil
+}
+
+// splitRuns splits the runs at every sep rune. A trailing empty section is dropped.
+func splitRuns(runs []Run, sep rune) [][]Run {
+	var (
+		sections [][]Run
+		current  []Run
+	)
+	for _, run := range runs {
+		parts := strings.Split(run.Text, string(sep))
+		for i, part := range parts {
+			if i != 0 {
+				sections = append(sections, current)
+				current = nil
+			}
+			if part != "" {
+				current = append(current, Run{Text: part, Style: run.Style})
+			}
+		}
+	}
+	if current != nil || len(sections) == 0 {
+		sections = append(sections, current)
+	}
+	return sections
+}
+
+// PageLink returns a copy of text that changes the book page to page when clicked.
+// Page numbers start at 1.
+func PageLink(text Component, page int) Component {
+	link := Clone(text)
+	link.Style().ClickEvent = ChangePage(strconv.Itoa(page))
+	return link
+}
@@ -0,0 +1,54 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+)
+
+func TestPaginator_Paginate(t *testing.T) {
+	plain := func(pages []Component) (texts []string) {
+		for _, page := range pages {
+			b := new(strings.Builder)
+			require.NoError(t, (&codec.Plain{}).Marshal(b, page))
+			texts = append(texts, b.String())
+		}
+		return texts
+	}
+
+	// 11 words of 6 pixels separated by 4 pixel spaces fit on a 114 pixel line
+	line := strings.Repeat("a ", 11)
+	pages, err := (&Paginator{}).Paginate(&Text{Content: strings.Repeat(line, 15)})
+	require.NoError(t, err)
+	require.Len(t, pages, 2)
+	texts := plain(pages)
+	require.Len(t, strings.Split(texts[0], "\n"), BookPageLines)
+	require.Equal(t, strings.TrimSpace(line), strings.TrimSpace(texts[1]))
+
+	// forced page breaks
+	pages, err = (&Paginator{}).Paginate(&Text{
+		Content: "Rules\f",
+		S:       Style{Bold: True},
+		Extra:   []Component{&Text{Content: "1. Be nice\f"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Rules", "1. Be nice"}, plain(pages))
+	require.True(t, Equal(&Text{Extra: []Component{&Text{Content: "Rules", S: Style{Bold: True}}}}, pages[0]))
+
+	// footer with navigation
+	p := &Paginator{Lines: 3, Footer: func(page, pages int) Component {
+		if page == pages {
+			return nil
+		}
+		return PageLink(&Text{Content: "Next", S: Style{Color: color.Blue}}, page+1)
+	}}
+	pages, err = p.Paginate(&Text{Content: "a\nb\nc"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a\nb\nNext", "c"}, plain(pages))
+	footer := pages[0].Children()[len(pages[0].Children())-1]
+	require.Equal(t, ChangePage("2"), footer.Style().ClickEvent)
+}
@@ -0,0 +1,217 @@
+package component
+
+import (
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/key"
+)
+
+// Builder builds a Component using chained calls.
+//
+//	c := component.BuildText("Hello ").
+//		Color(color.Gold).Bold().
+//		AppendText("world").
+//		Click(component.OpenUrl("https://example.com")).
+//		Build()
+//
+// The style methods always apply to the component being built,
+// appended children inherit that style.
+type Builder struct {
+	c     Component
+	extra []Component // only used for components whose children are not extras
+}
+
+// BuildText returns a Builder for a Text component.
+func BuildText(content string) *Builder {
+	return &Builder{c: &Text{Content: content}}
+}
+
+// BuildTranslation returns a Builder for a Translation component
+// with the given translation arguments.
+func BuildTranslation(key string, with ...Component) *Builder {
+	return &Builder{c: &Translation{Key: key, With: with}}
+}
+
+// BuildScore returns a Builder for a Score component.
+func BuildScore(name, objective string) *Builder {
+	return &Builder{c: &Score{Name: name, Objective: objective}}
+}
+
+// BuildSelector returns a Builder for a Selector component.
+func BuildSelector(pattern string) *Builder {
+	return &Builder{c: &Selector{Pattern: pattern}}
+}
+
+// BuildKeybind returns a Builder for a Keybind component.
+func BuildKeybind(key string) *Builder {
+	return &Builder{c: &Keybind{Key: key}}
+}
+
+// BuildNBT returns a Builder for an NBT component.
+func BuildNBT(path string, source NBTSource) *Builder {
+	return &Builder{c: &NBT{Path: path, Source: source}}
+}
+
+// BuildObject returns a Builder for an Object component.
+func BuildObject(contents ObjectContents) *Builder {
+	return &Builder{c: &Object{Contents: contents}}
+}
+
+// Fallback sets the text used when a Translation key is unknown to the client.
+// It has no effect on other components.
+func (b *Builder) Fallback(fallback string) *Builder {
+	if t, ok := b.c.(*Translation); ok {
+		t.Fallback = fallback
+	}
+	return b
+}
+
+// Args appends translation arguments to a Translation.
+// It has no effect on other components.
+func (b *Builder) Args(args ...Component) *Builder {
+	if t, ok := b.c.(*Translation); ok {
+		t.With = append(t.With, args...)
+	}
+	return b
+}
+
+// Separator sets the separator of a Selector or NBT component.
+// It has no effect on other components.
+func (b *Builder) Separator(separator Component) *Builder {
+	switch c := b.c.(type) {
+	case *Selector:
+		c.Separator = separator
+	case *NBT:
+		c.Separator = separator
+	}
+	return b
+}
+
+// Interpret sets whether the value of an NBT component is interpreted as a component.
+// It has no effect on other components.
+func (b *Builder) Interpret(interpret bool) *Builder {
+	if n, ok := b.c.(*NBT); ok {
+		n.Interpret = interpret
+	}
+	return b
+}
+
+// Style merges all set fields of s into the style.
+func (b *Builder) Style(s Style) *Builder {
+	b.c.Style().Merge(&s, MergeAlways, MergeAll)
+	return b
+}
+
+// Color sets the text color.
+func (b *Builder) Color(c color.Color) *Builder {
+	b.c.Style().Color = c
+	return b
+}
+
+// ShadowColor sets the text shadow color.
+func (b *Builder) ShadowColor(c color.ARGB) *Builder {
+	b.c.Style().ShadowColor = &c
+	return b
+}
+
+// Decoration sets the state of a decoration.
+func (b *Builder) Decoration(d Decoration, state State) *Builder {
+	b.c.Style().SetDecoration(d, state)
+	return b
+}
+
+// Decorate enables the given decorations.
+func (b *Builder) Decorate(decorations ...Decoration) *Builder {
+	for _, d := range decorations {
+		b.Decoration(d, True)
+	}
+	return b
+}
+
+// Bold makes the text bold.
+func (b *Builder) Bold() *Builder { return b.Decorate(Bold) }
+
+// Italic makes the text italic.
+func (b *Builder) Italic() *Builder { return b.Decorate(Italic) }
+
+// Underlined makes the text underlined.
+func (b *Builder) Underlined() *Builder { return b.Decorate(Underlined) }
+
+// Strikethrough strikes the text through.
+func (b *Builder) Strikethrough() *Builder { return b.Decorate(Strikethrough) }
+
+// Obfuscated makes the text obfuscated.
+func (b *Builder) Obfuscated() *Builder { return b.Decorate(Obfuscated) }
+
+// Click sets the click event.
+func (b *Builder) Click(e ClickEvent) *Builder {
+	b.c.Style().ClickEvent = e
+	return b
+}
+
+// Hover sets the hover event.
+func (b *Builder) Hover(e HoverEvent) *Builder {
+	b.c.Style().HoverEvent = e
+	return b
+}
+
+// HoverText sets a hover event showing the given text.
+func (b *Builder) HoverText(text Component) *Builder {
+	return b.Hover(ShowText(text))
+}
+
+// Font sets the font.
+func (b *Builder) Font(font key.Key) *Builder {
+	b.c.Style().Font = font
+	return b
+}
+
+// Insertion sets the text inserted into the chat when the component is shift-clicked.
+func (b *Builder) Insertion(insertion string) *Builder {
+	b.c.Style().Insertion = &insertion
+	return b
+}
+
+// Append appends children. Nil children are ignored.
+func (b *Builder) Append(children ...Component) *Builder {
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+		if _, ok := b.c.(*Translation); ok {
+			b.extra = append(b.extra, child)
+			continue
+		}
+		b.c.SetChildren(append(b.c.Children(), child))
+	}
+	return b
+}
+
+// AppendText appends an unstyled Text child.
+func (b *Builder) AppendText(content string) *Builder {
+	return b.Append(&Text{Content: content})
+}
+
+// AppendBuilt appends the components built by the given builders.
+func (b *Builder) AppendBuilt(builders ...*Builder) *Builder {
+	for _, child := range builders {
+		b.Append(child.Build())
+	}
+	return b
+}
+
+// Build returns the built component. The builder can be used again afterwards
+// without affecting already built components.
+//
+// Since the children of a Translation are its arguments, a Translation with
+// appended children is built as a Text carrying the style with the Translation
+// as its first child.
+func (b *Builder) Build() Component {
+	c := Clone(b.c)
+	if len(b.extra) == 0 {
+		return c
+	}
+	t := c.(*Translation)
+	s := t.S
+	t.S = Style{}
+	return &Text{S: s, Extra: append([]Component{t}, cloneComponents(b.extra)...)}
+}
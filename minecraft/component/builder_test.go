@@ -0,0 +1,93 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+func TestBuilder(t *testing.T) {
+	b := BuildText("Hello ").
+		Color(color.Gold).
+		Bold().
+		Decoration(Italic, False).
+		ShadowColor(color.ARGB(0xff000000)).
+		Click(OpenUrl("https://example.com")).
+		HoverText(&Text{Content: "Tooltip"}).
+		Font(key.New(key.MinecraftNamespace, "uniform")).
+		Insertion("hi").
+		AppendText("world").
+		AppendBuilt(BuildText("!").Color(color.Red))
+
+	shadow := color.ARGB(0xff000000)
+	insertion := "hi"
+	expected := &Text{
+		Content: "Hello ",
+		S: Style{
+			Color:       color.Gold,
+			ShadowColor: &shadow,
+			Bold:        True,
+			Italic:      False,
+			ClickEvent:  OpenUrl("https://example.com"),
+			HoverEvent:  ShowText(&Text{Content: "Tooltip"}),
+			Font:        key.New(key.MinecraftNamespace, "uniform"),
+			Insertion:   &insertion,
+		},
+		Extra: []Component{
+			&Text{Content: "world"},
+			&Text{Content: "!", S: Style{Color: color.Red}},
+		},
+	}
+	built := b.Build()
+	require.True(t, Equal(expected, built))
+
+	// reusing the builder does not affect built components
+	b.AppendText("?")
+	require.True(t, Equal(expected, built))
+	require.Len(t, b.Build().Children(), 3)
+}
+
+func TestBuilder_kinds(t *testing.T) {
+	require.Equal(t, &Translation{
+		Key:      "chat.type.text",
+		Fallback: "<%s> %s",
+		With:     []Component{&Text{Content: "Bob"}, &Text{Content: "Hi"}},
+		S:        Style{Color: color.Gray},
+	}, BuildTranslation("chat.type.text", &Text{Content: "Bob"}).
+		Args(&Text{Content: "Hi"}).
+		Fallback("<%s> %s").
+		Color(color.Gray).
+		Build())
+
+	require.Equal(t, &Text{
+		S: Style{Color: color.Gray},
+		Extra: []Component{
+			&Translation{Key: "a"},
+			&Text{Content: "b"},
+		},
+	}, BuildTranslation("a").Color(color.Gray).AppendText("b").Build())
+
+	require.Equal(t, &Score{Name: "@p", Objective: "kills", S: Style{Italic: True}},
+		BuildScore("@p", "kills").Italic().Build())
+	require.Equal(t, &Selector{Pattern: "@a", Separator: &Text{Content: " | "}},
+		BuildSelector("@a").Separator(&Text{Content: " | "}).Build())
+	require.Equal(t, &Keybind{Key: "key.jump", S: Style{Underlined: True}},
+		BuildKeybind("key.jump").Underlined().Build())
+	require.Equal(t, &NBT{Path: "Health", Interpret: true, Source: &EntityNBTSource{Selector: "@s"}},
+		BuildNBT("Health", &EntityNBTSource{Selector: "@s"}).Interpret(true).Build())
+	require.Equal(t, &Object{Contents: &AtlasSprite{Sprite: key.New(key.MinecraftNamespace, "item/apple")}},
+		BuildObject(&AtlasSprite{Sprite: key.New(key.MinecraftNamespace, "item/apple")}).Build())
+}
+
+func TestBuilder_primitive(t *testing.T) {
+	require.Equal(t, &Translation{
+		Key:  "a",
+		With: []Component{&Primitive{Value: true}},
+		S:    Style{Color: color.Gray},
+	}, BuildTranslation("a", &Primitive{Value: true}).Color(color.Gray).Build())
+	require.Equal(t, &Primitive{Value: int64(3), S: Style{Bold: True}},
+		Compact(BuildText("").Append(&Primitive{Value: int64(3)}).Bold().Build()))
+}
@@ -0,0 +1,185 @@
+package component
+
+// Cloner can be implemented by custom Component, ClickEvent and HoverEvent types and by custom
+// hover event values to be deep copied by Clone, CloneClickEvent, CloneHoverEvent and Style.Clone.
+// Clone must return a deep copy of the same type.
+type Cloner interface {
+	Clone() interface{}
+}
+
+// Clone returns a deep copy of the component tree rooted at c,
+// including styles, events, separators and object contents.
+//
+// Components of unknown types are copied using Cloner, or returned as is if they do not implement it.
+func Clone(c Component) Component {
+	switch t := c.(type) {
+	case nil:
+		return nil
+	case *Text:
+		return &Text{
+			Content: t.Content,
+			S:       t.S.Clone(),
+			Extra:   cloneComponents(t.Extra),
+		}
+	case *Translation:
+		return &Translation{
+			Key:      t.Key,
+			Fallback: t.Fallback,
+			S:        t.S.Clone(),
+			With:     cloneComponents(t.With),
+		}
+	case *Primitive:
+		return &Primitive{Value: t.Value, S: t.S.Clone()}
+	case *Score:
+		return &Score{
+			Name:      t.Name,
+			Objective: t.Objective,
+			Value:     t.Value,
+			S:         t.S.Clone(),
+			Extra:     cloneComponents(t.Extra),
+		}
+	case *Selector:
+		return &Selector{
+			Pattern:   t.Pattern,
+			Separator: Clone(t.Separator),
+			S:         t.S.Clone(),
+			Extra:     cloneComponents(t.Extra),
+		}
+	case *NBT:
+		return &NBT{
+			Path:      t.Path,
+			Interpret: t.Interpret,
+			Separator: Clone(t.Separator),
+			Source:    cloneNBTSource(t.Source),
+			S:         t.S.Clone(),
+			Extra:     cloneComponents(t.Extra),
+		}
+	case *Object:
+		return &Object{
+			Contents: cloneObjectContents(t.Contents),
+			S:        t.S.Clone(),
+			Extra:    cloneComponents(t.Extra),
+		}
+	case *Keybind:
+		return &Keybind{
+			Key:   t.Key,
+			S:     t.S.Clone(),
+			Extra: cloneComponents(t.Extra),
+		}
+	case Cloner:
+		if cp, ok := t.Clone().(Component); ok {
+			return cp
+		}
+	}
+	return c
+}
+
+func cloneComponents(cs []Component) []Component {
+	if cs == nil {
+		return nil
+	}
+	cp := make([]Component, len(cs))
+	for i, c := range cs {
+		cp[i] = Clone(c)
+	}
+	return cp
+}
+
+func cloneNBTSource(src NBTSource) NBTSource {
+	switch t := src.(type) {
+	case *BlockNBTSource:
+		cp := *t
+		return &cp
+	case *EntityNBTSource:
+		cp := *t
+		return &cp
+	case *StorageNBTSource:
+		cp := *t
+		return &cp
+	}
+	return src
+}
+
+func cloneObjectContents(contents ObjectContents) ObjectContents {
+	switch t := contents.(type) {
+	case *AtlasSprite:
+		cp := *t
+		return &cp
+	case *PlayerHead:
+		cp := *t
+		if t.Profile.Properties != nil {
+			cp.Profile.Properties = append([]ProfileProperty(nil), t.Profile.Properties...)
+		}
+		return &cp
+	}
+	return contents
+}
+
+// Clone returns a deep copy of the Style.
+func (s *Style) Clone() Style {
+	cp := *s
+	if s.ShadowColor != nil {
+		shadow := *s.ShadowColor
+		cp.ShadowColor = &shadow
+	}
+	if s.Insertion != nil {
+		insertion := *s.Insertion
+		cp.Insertion = &insertion
+	}
+	cp.ClickEvent = CloneClickEvent(s.ClickEvent)
+	cp.HoverEvent = CloneHoverEvent(s.HoverEvent)
+	return cp
+}
+
+// CloneClickEvent returns a copy of the ClickEvent with the same ClickAction.
+//
+// Click events of unknown types are copied using Cloner, or returned as is if they do not implement it.
+func CloneClickEvent(e ClickEvent) ClickEvent {
+	switch t := e.(type) {
+	case nil:
+		return nil
+	case *clickEvent:
+		return &clickEvent{action: t.action, value: t.value}
+	case Cloner:
+		if cp, ok := t.Clone().(ClickEvent); ok {
+			return cp
+		}
+	}
+	return e
+}
+
+// CloneHoverEvent returns a deep copy of the HoverEvent with the same HoverAction,
+// copying its value such as a *Text, *ShowItemHoverType or *ShowEntityHoverType.
+//
+// Hover events and hover event values of unknown types are copied using Cloner,
+// or kept as is if they do not implement it.
+func CloneHoverEvent(e HoverEvent) HoverEvent {
+	switch t := e.(type) {
+	case nil:
+		return nil
+	case *hoverEvent:
+		return &hoverEvent{action: t.action, value: cloneHoverValue(t.value)}
+	case Cloner:
+		if cp, ok := t.Clone().(HoverEvent); ok {
+			return cp
+		}
+	}
+	return e
+}
+
+func cloneHoverValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case Component:
+		return Clone(t)
+	case *ShowItemHoverType:
+		cp := *t
+		return &cp
+	case *ShowEntityHoverType:
+		cp := *t
+		cp.Name = Clone(t.Name)
+		return &cp
+	case Cloner:
+		return t.Clone()
+	}
+	return v
+}
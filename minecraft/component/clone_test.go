@@ -0,0 +1,92 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+type customClickAction struct{}
+
+func (customClickAction) Name() string   { return "custom_action" }
+func (customClickAction) Readable() bool { return true }
+
+type customHoverValue struct{ lines []string }
+
+func (v *customHoverValue) Clone() interface{} {
+	return &customHoverValue{lines: append([]string(nil), v.lines...)}
+}
+
+func TestClone(t *testing.T) {
+	insertion := "insert me"
+	shadow := color.MakeARGB(color.Black, 0x80)
+	original := &Text{
+		Content: "Hello",
+		S: Style{
+			Color:       color.Gold,
+			ShadowColor: &shadow,
+			Insertion:   &insertion,
+			ClickEvent:  NewClickEvent(customClickAction{}, "value"),
+			HoverEvent: ShowEntity(&ShowEntityHoverType{
+				Type: key.New(key.MinecraftNamespace, "player"),
+				Id:   uuid.New(),
+				Name: &Text{Content: "Steve"},
+			}),
+		},
+		Extra: []Component{
+			&Translation{Key: "a", With: []Component{&Primitive{Value: int64(1)}}},
+			&Selector{Pattern: "@p", Separator: &Text{Content: ", "}},
+			&NBT{Path: "Pos", Source: &EntityNBTSource{Selector: "@s"}},
+			&Object{Contents: &PlayerHead{Profile: PlayerProfile{
+				Name:       "Notch",
+				Properties: []ProfileProperty{{Name: "textures", Value: "abc"}},
+			}}},
+			&Score{Name: "@s", Objective: "kills"},
+			&Keybind{Key: "key.jump", S: Style{HoverEvent: ShowItem(&ShowItemHoverType{
+				Item:  key.New(key.MinecraftNamespace, "stone"),
+				Count: 1,
+			})}},
+		},
+	}
+
+	cp := Clone(original).(*Text)
+	require.Equal(t, original, cp)
+
+	// custom actions are kept
+	require.Equal(t, customClickAction{}, cp.S.ClickEvent.Action())
+
+	// mutate the copy everywhere
+	*cp.S.Insertion = "changed"
+	*cp.S.ShadowColor = 0
+	cp.S.HoverEvent.Value().(*ShowEntityHoverType).Name.(*Text).Content = "Alex"
+	cp.Extra[0].(*Translation).With[0].(*Primitive).Value = int64(2)
+	cp.Extra[1].(*Selector).Separator.(*Text).Content = "; "
+	cp.Extra[2].(*NBT).Source.(*EntityNBTSource).Selector = "@p"
+	cp.Extra[3].(*Object).Contents.(*PlayerHead).Profile.Properties[0].Value = "def"
+	cp.Extra[5].(*Keybind).S.HoverEvent.Value().(*ShowItemHoverType).Count = 64
+	cp.Extra = append(cp.Extra[:1], &Text{})
+
+	require.Equal(t, "insert me", *original.S.Insertion)
+	require.Equal(t, shadow, *original.S.ShadowColor)
+	require.Equal(t, "Steve", original.S.HoverEvent.Value().(*ShowEntityHoverType).Name.(*Text).Content)
+	require.Equal(t, int64(1), original.Extra[0].(*Translation).With[0].(*Primitive).Value)
+	require.Equal(t, ", ", original.Extra[1].(*Selector).Separator.(*Text).Content)
+	require.Equal(t, "@s", original.Extra[2].(*NBT).Source.(*EntityNBTSource).Selector)
+	require.Equal(t, "abc", original.Extra[3].(*Object).Contents.(*PlayerHead).Profile.Properties[0].Value)
+	require.Equal(t, 1, original.Extra[5].(*Keybind).S.HoverEvent.Value().(*ShowItemHoverType).Count)
+	require.Len(t, original.Extra, 6)
+}
+
+func TestCloneHoverEvent_cloner(t *testing.T) {
+	value := &customHoverValue{lines: []string{"a"}}
+	event := NewHoverEvent(ShowTextAction, value)
+
+	cp := CloneHoverEvent(event)
+	require.Equal(t, event, cp)
+	cp.Value().(*customHoverValue).lines[0] = "b"
+	require.Equal(t, "a", value.lines[0])
+}
@@ -0,0 +1,361 @@
+package ansi
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+)
+
+// ColorMode is the color support of a terminal.
+type ColorMode uint8
+
+// Color modes.
+const (
+	// TrueColor uses 24-bit colors.
+	TrueColor ColorMode = iota
+	// Color256 uses the 256 colors of the xterm palette.
+	// Named colors use the 16 standard colors of the palette.
+	Color256
+	// Color16 uses the 16 standard colors, the nearest named color of hex colors.
+	Color16
+)
+
+// ANSI is a codec for text with ANSI escape sequences (SGR) used by terminals.
+type ANSI struct {
+	// ColorMode is the color support of the terminal, TrueColor by default.
+	ColorMode ColorMode
+
+	// ObfuscatedChar replaces all characters of obfuscated text except spaces.
+	// If zero, obfuscated text blinks instead.
+	ObfuscatedChar rune
+
+	// The optional Translator to render Translation components with.
+	// Translations it does not know are rendered using their fallback or key.
+	Translator Translator
+}
+
+var _ codec.Codec = (*ANSI)(nil)
+
+const (
+	esc   = "\x1b["
+	reset = esc + "0m"
+)
+
+// SGR parameters.
+const (
+	sgrReset            = 0
+	sgrBold             = 1
+	sgrItalic           = 3
+	sgrUnderline        = 4
+	sgrBlink            = 5
+	sgrRapidBlink       = 6
+	sgrStrikethrough    = 9
+	sgrNormalIntensity  = 22
+	sgrNotItalic        = 23
+	sgrNotUnderlined    = 24
+	sgrNotBlinking      = 25
+	sgrNotStrikethrough = 29
+	sgrForeground       = 38
+	sgrDefaultColor     = 39
+	sgrBackground       = 48
+	sgrColor256         = 5
+	sgrTrueColor        = 2
+)
+
+// namedCodes are the SGR foreground codes of the named colors, in the order of color.NamesOrder.
+var namedCodes = []int{30, 34, 32, 36, 31, 35, 33, 37, 90, 94, 92, 96, 91, 95, 93, 97}
+
+// Marshal writes c with ANSI escape sequences for its styles.
+func (a *ANSI) Marshal(wr io.Writer, c Component) error {
+	f := &Flattener{Translator: a.Translator}
+	runs, err := f.Runs(c)
+	if err != nil {
+		return err
+	}
+	b := new(strings.Builder)
+	var styled bool
+	for _, run := range runs {
+		params := a.params(&run.Style)
+		if len(params) != 0 {
+			b.WriteString(esc + "0;" + strings.Join(params, ";") + "m")
+			styled = true
+		} else if styled {
+			b.WriteString(reset)
+			styled = false
+		}
+		text := run.Text
+		if run.Style.Obfuscated == True && a.ObfuscatedChar != 0 {
+			text = strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return r
+				}
+				return a.ObfuscatedChar
+			}, text)
+		}
+		b.WriteString(text)
+	}
+	if styled {
+		b.WriteString(reset)
+	}
+	_, err = wr.Write([]byte(b.String()))
+	return err
+}
+
+// params returns the SGR parameters of the effective style.
+func (a *ANSI) params(s *Style) (params []string) {
+	add := func(codes ...int) {
+		for _, code := range codes {
+			params = append(params, strconv.Itoa(code))
+		}
+	}
+	if s.Bold == True {
+		add(sgrBold)
+	}
+	if s.Italic == True {
+		add(sgrItalic)
+	}
+	if s.Underlined == True {
+		add(sgrUnderline)
+	}
+	if s.Obfuscated == True && a.ObfuscatedChar == 0 {
+		add(sgrBlink)
+	}
+	if s.Strikethrough == True {
+		add(sgrStrikethrough)
+	}
+	if s.Color != nil {
+		add(a.colorCodes(s.Color)...)
+	}
+	return params
+}
+
+// colorCodes returns the SGR parameters of the foreground color.
+func (a *ANSI) colorCodes(c color.Color) []int {
+	named, isNamed := c.(*color.Named)
+	switch a.ColorMode {
+	case Color16:
+		return []int{namedCodes[namedIndex(c.Named())]}
+	case Color256:
+		if isNamed {
+			return []int{sgrForeground, sgrColor256, paletteIndex(named)}
+		}
+		r, g, b := rgb(c)
+		return []int{sgrForeground, sgrColor256, nearest256(r, g, b)}
+	default:
+		r, g, b := rgb(c)
+		return []int{sgrForeground, sgrTrueColor, int(r), int(g), int(b)}
+	}
+}
+
+func rgb(c color.Color) (r, g, b uint8) {
+	r32, g32, b32, _ := c.RGBA()
+	return uint8(r32 >> 8), uint8(g32 >> 8), uint8(b32 >> 8)
+}
+
+func namedIndex(n *color.Named) int {
+	for i, test := range color.NamesOrder {
+		if test == n {
+			return i
+		}
+	}
+	return 0
+}
+
+// paletteIndex returns the index of the named color in the 16 standard colors of the palette.
+func paletteIndex(n *color.Named) int {
+	code := namedCodes[namedIndex(n)]
+	if code >= 90 {
+		return code - 90 + 8
+	}
+	return code - 30
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube of the xterm palette.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// nearest256 returns the index of the nearest color cube or grayscale color of the xterm palette.
+func nearest256(r, g, b uint8) int {
+	nearestLevel := func(v uint8) int {
+		best := 0
+		for i, level := range cubeLevels {
+			if abs(int(v)-level) < abs(int(v)-cubeLevels[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	ri, gi, bi := nearestLevel(r), nearestLevel(g), nearestLevel(b)
+	cube := 16 + 36*ri + 6*gi + bi
+	cubeDist := distance(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	avg := (int(r) + int(g) + int(b)) / 3
+	gray := (avg - 3) / 10
+	if gray < 0 {
+		gray = 0
+	} else if gray > 23 {
+		gray = 23
+	}
+	level := 8 + 10*gray
+	if distance(r, g, b, level, level, level) < cubeDist {
+		return 232 + gray
+	}
+	return cube
+}
+
+// color256 returns the color of the index of the xterm palette.
+func color256(i int) color.Color {
+	switch {
+	case i < 16:
+		code := 30 + i
+		if i >= 8 {
+			code = 90 + i - 8
+		}
+		return namedByCode(code)
+	case i < 232:
+		i -= 16
+		return color.HexInt(cubeLevels[i/36]<<16 | cubeLevels[i/6%6]<<8 | cubeLevels[i%6])
+	default:
+		level := 8 + 10*(i-232)
+		return color.HexInt(level<<16 | level<<8 | level)
+	}
+}
+
+func namedByCode(code int) color.Color {
+	for i, test := range namedCodes {
+		if test == code {
+			return color.NamesOrder[i]
+		}
+	}
+	return nil
+}
+
+func distance(r, g, b uint8, r2, g2, b2 int) int {
+	dr, dg, db := int(r)-r2, int(g)-g2, int(b)-b2
+	return dr*dr + dg*dg + db*db
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// decode
+// decode
+// decode
+
+// Unmarshal parses text with ANSI escape sequences into a component.
+// Foreground colors and the decorations are kept, blinking text becomes obfuscated.
+// Other escape sequences are dropped.
+func (a *ANSI) Unmarshal(data []byte) (Component, error) {
+	input := string(data)
+	var (
+		root  = &Text{}
+		style Style
+		text  strings.Builder
+	)
+	flush := func() {
+		if text.Len() != 0 {
+			root.Extra = append(root.Extra, &Text{Content: text.String(), S: style.Clone()})
+			text.Reset()
+		}
+	}
+	for i := 0; i < len(input); {
+		if !strings.HasPrefix(input[i:], esc) {
+			text.WriteByte(input[i])
+			i++
+			continue
+		}
+		// find the final byte of the control sequence
+		end := i + len(esc)
+		for end < len(input) && (input[end] < 0x40 || input[end] > 0x7e) {
+			end++
+		}
+		if end == len(input) {
+			break // incomplete sequence
+		}
+		if input[end] == 'm' {
+			flush()
+			applySGR(&style, input[i+len(esc):end])
+		}
+		i = end + 1
+	}
+	flush()
+	return Compact(root), nil
+}
+
+// applySGR applies the SGR parameters to the style.
+func applySGR(s *Style, params string) {
+	codes := strings.Split(params, ";")
+	next := func(i int) (int, bool) {
+		if i >= len(codes) {
+			return 0, false
+		}
+		n, err := strconv.Atoi(codes[i])
+		return n, err == nil
+	}
+	for i := 0; i < len(codes); i++ {
+		code, ok := next(i)
+		if codes[i] == "" {
+			code, ok = sgrReset, true // an empty parameter is a reset
+		}
+		if !ok {
+			continue
+		}
+		switch {
+		case code == sgrReset:
+			*s = Style{}
+		case code == sgrBold:
+			s.Bold = True
+		case code == sgrItalic:
+			s.Italic = True
+		case code == sgrUnderline:
+			s.Underlined = True
+		case code == sgrBlink, code == sgrRapidBlink:
+			s.Obfuscated = True
+		case code == sgrStrikethrough:
+			s.Strikethrough = True
+		case code == sgrNormalIntensity:
+			s.Bold = NotSet
+		case code == sgrNotItalic:
+			s.Italic = NotSet
+		case code == sgrNotUnderlined:
+			s.Underlined = NotSet
+		case code == sgrNotBlinking:
+			s.Obfuscated = NotSet
+		case code == sgrNotStrikethrough:
+			s.Strikethrough = NotSet
+		case code == sgrDefaultColor:
+			s.Color = nil
+		case (code >= 30 && code <= 37) || (code >= 90 && code <= 97):
+			s.Color = namedByCode(code)
+		case code == sgrForeground, code == sgrBackground:
+			mode, _ := next(i + 1)
+			var c color.Color
+			switch mode {
+			case sgrColor256:
+				if n, ok := next(i + 2); ok && n >= 0 && n < 256 {
+					c = color256(n)
+				}
+				i += 2
+			case sgrTrueColor:
+				r, okR := next(i + 2)
+				g, okG := next(i + 3)
+				b, okB := next(i + 4)
+				if okR && okG && okB {
+					c = color.HexInt((r&0xff)<<16 | (g&0xff)<<8 | b&0xff)
+				}
+				i += 4
+			}
+			if code == sgrForeground && c != nil {
+				s.Color = c
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package ansi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+var txt = &Text{
+	Content: "Hello ",
+	S:       Style{Color: color.Gold},
+	Extra: []Component{
+		&Text{Content: "there", S: Style{Bold: True, Color: color.HexInt(0x123456)}},
+		&Text{Content: "!", S: Style{Color: color.Gold}},
+	},
+}
+
+func marshal(t *testing.T, a *ANSI, c Component) string {
+	b := new(strings.Builder)
+	require.NoError(t, a.Marshal(b, c))
+	return b.String()
+}
+
+func TestANSI_Marshal(t *testing.T) {
+	require.Equal(t,
+		"\x1b[0;38;2;255;170;0mHello \x1b[0;1;38;2;18;52;86mthere\x1b[0;38;2;255;170;0m!\x1b[0m",
+		marshal(t, &ANSI{}, txt))
+	require.Equal(t,
+		"\x1b[0;38;5;3mHello \x1b[0;1;38;5;23mthere\x1b[0;38;5;3m!\x1b[0m",
+		marshal(t, &ANSI{ColorMode: Color256}, txt))
+	require.Equal(t,
+		"\x1b[0;33mHello \x1b[0;1;90mthere\x1b[0;33m!\x1b[0m",
+		marshal(t, &ANSI{ColorMode: Color16}, txt))
+
+	require.Equal(t, "plain", marshal(t, &ANSI{}, &Text{Content: "plain"}))
+	require.Equal(t, "a\x1b[0;3;4;9mb\x1b[0mc", marshal(t, &ANSI{}, &Text{Content: "a", Extra: []Component{
+		&Text{Content: "b", S: Style{Italic: True, Underlined: True, Strikethrough: True}},
+		&Text{Content: "c"},
+	}}))
+}
+
+func TestANSI_Marshal_obfuscated(t *testing.T) {
+	c := &Text{Content: "secret code", S: Style{Obfuscated: True}}
+	require.Equal(t, "\x1b[0;5msecret code\x1b[0m", marshal(t, &ANSI{}, c))
+	require.Equal(t, "****** ****", marshal(t, &ANSI{ObfuscatedChar: '*'}, c))
+}
+
+func TestNearest256(t *testing.T) {
+	require.Equal(t, 16, nearest256(0, 0, 0))
+	require.Equal(t, 231, nearest256(255, 255, 255))
+	require.Equal(t, 196, nearest256(255, 0, 0))
+	require.Equal(t, 244, nearest256(128, 128, 128))
+	for i := 16; i < 256; i++ {
+		r, g, b := rgb(color256(i))
+		require.Equal(t, color256(i).Hex(), color256(nearest256(r, g, b)).Hex(), "%d", i)
+	}
+}
+
+func TestANSI_Unmarshal(t *testing.T) {
+	a := &ANSI{}
+	c, err := a.Unmarshal([]byte("\x1b[0;33mHello \x1b[1;38;2;18;52;86mthere\x1b[22;39m! \x1b[38;5;196;4mred\x1b[m\x1b[2Kend"))
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "Hello ", S: Style{Color: color.Gold}},
+		&Text{Content: "there", S: Style{Bold: True, Color: color.HexInt(0x123456)}},
+		&Text{Content: "! "},
+		&Text{Content: "red", S: Style{Color: color.HexInt(0xff0000), Underlined: True}},
+		&Text{Content: "end"},
+	}}, c), "%#v", c)
+
+	// round trip
+	for _, mode := range []ColorMode{TrueColor, Color256, Color16} {
+		a := &ANSI{ColorMode: mode}
+		c, err := a.Unmarshal([]byte(marshal(t, a, txt)))
+		require.NoError(t, err)
+		require.Equal(t, marshal(t, a, txt), marshal(t, a, c))
+	}
+}
@@ -0,0 +1,163 @@
+package html
+
+import (
+	stdhtml "html"
+	"io"
+	"net/url"
+	"strings"
+
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+)
+
+// StyleMode is how styles are rendered.
+type StyleMode uint8
+
+// Style modes.
+const (
+	// InlineStyle renders styles as inline CSS in style attributes.
+	InlineStyle StyleMode = iota
+	// CSSClass renders named colors and decorations as CSS classes,
+	// e.g. "mc-gold" and "mc-bold", to be styled by a stylesheet.
+	// Hex colors are always rendered inline.
+	CSSClass
+)
+
+// DefaultClassPrefix is the default prefix of CSS classes.
+const DefaultClassPrefix = "mc-"
+
+// HTML is an encoder of components to HTML for web pages.
+//
+// All text is escaped. Every text is rendered as a span with its effective style,
+// "open_url" click events with http or https URLs become links and "show_text"
+// hover events become title attributes shown as tooltips. Newlines become line breaks.
+type HTML struct {
+	// StyleMode is how styles are rendered, InlineStyle by default.
+	StyleMode StyleMode
+	// ClassPrefix is the prefix of the CSS classes, DefaultClassPrefix if empty.
+	ClassPrefix string
+
+	// The optional Translator to render Translation components with.
+	// Translations it does not know are rendered using their fallback or key.
+	Translator Translator
+}
+
+var _ codec.Marshaler = (*HTML)(nil)
+
+// Marshal writes c as HTML.
+func (h *HTML) Marshal(wr io.Writer, c Component) error {
+	f := &Flattener{Translator: h.Translator}
+	runs, err := f.Runs(c)
+	if err != nil {
+		return err
+	}
+	b := new(strings.Builder)
+	for i := range runs {
+		if err = h.encodeRun(b, &runs[i]); err != nil {
+			return err
+		}
+	}
+	_, err = wr.Write([]byte(b.String()))
+	return err
+}
+
+func (h *HTML) encodeRun(b *strings.Builder, run *Run) error {
+	var attrs []string
+	if classes := h.classes(&run.Style); len(classes) != 0 {
+		attrs = append(attrs, `class="`+strings.Join(classes, " ")+`"`)
+	}
+	if css := h.css(&run.Style); len(css) != 0 {
+		attrs = append(attrs, `style="`+strings.Join(css, ";")+`"`)
+	}
+	if e := run.Style.HoverEvent; e != nil && e.Action() == ShowTextAction {
+		if text, ok := e.Value().(Component); ok && text != nil {
+			title := new(strings.Builder)
+			if err := (&codec.Plain{Translator: h.Translator}).Marshal(title, text); err != nil {
+				return err
+			}
+			attrs = append(attrs, `title="`+stdhtml.EscapeString(title.String())+`"`)
+		}
+	}
+
+	link := linkOf(run.Style.ClickEvent)
+	if link != "" {
+		b.WriteString(`<a href="` + stdhtml.EscapeString(link) + `" target="_blank" rel="noopener noreferrer">`)
+	}
+	if len(attrs) != 0 {
+		b.WriteString("<span " + strings.Join(attrs, " ") + ">")
+	}
+	b.WriteString(strings.Replace(stdhtml.EscapeString(run.Text), "\n", "<br>", -1))
+	if len(attrs) != 0 {
+		b.WriteString("</span>")
+	}
+	if link != "" {
+		b.WriteString("</a>")
+	}
+	return nil
+}
+
+// linkOf returns the URL of an "open_url" click event if it is a http or https URL.
+func linkOf(e ClickEvent) string {
+	if e == nil || e.Action() != OpenUrlAction {
+		return ""
+	}
+	u, err := url.Parse(e.Value())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return u.String()
+}
+
+func (h *HTML) classes(s *Style) (classes []string) {
+	if h.StyleMode != CSSClass {
+		return nil
+	}
+	prefix := h.ClassPrefix
+	if prefix == "" {
+		prefix = DefaultClassPrefix
+	}
+	if n, ok := s.Color.(*color.Named); ok {
+		classes = append(classes, prefix+n.Name)
+	}
+	for _, d := range DecorationsOrder {
+		if s.Decoration(d) == True {
+			classes = append(classes, prefix+string(d))
+		}
+	}
+	return classes
+}
+
+// decorationsCSS are the inline CSS declarations of the decorations except underlined and strikethrough.
+var decorationsCSS = map[Decoration]string{
+	Obfuscated: "filter:blur(0.2em)",
+	Bold:       "font-weight:bold",
+	Italic:     "font-style:italic",
+}
+
+func (h *HTML) css(s *Style) (css []string) {
+	if s.Color != nil {
+		if _, ok := s.Color.(*color.Named); !ok || h.StyleMode != CSSClass {
+			css = append(css, "color:"+s.Color.Hex())
+		}
+	}
+	if h.StyleMode == CSSClass {
+		return css
+	}
+	for _, d := range DecorationsOrder {
+		if declaration, ok := decorationsCSS[d]; ok && s.Decoration(d) == True {
+			css = append(css, declaration)
+		}
+	}
+	var lines []string
+	if s.Underlined == True {
+		lines = append(lines, "underline")
+	}
+	if s.Strikethrough == True {
+		lines = append(lines, "line-through")
+	}
+	if len(lines) != 0 {
+		css = append(css, "text-decoration:"+strings.Join(lines, " "))
+	}
+	return css
+}
@@ -0,0 +1,60 @@
+package html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+var txt = &Text{
+	Content: "Hello ",
+	S:       Style{Color: color.Gold},
+	Extra: []Component{
+		&Text{Content: "<there>", S: Style{Bold: True, Underlined: True, Color: color.HexInt(0x123456)}},
+		&Text{Content: "!"},
+	},
+}
+
+func marshal(t *testing.T, h *HTML, c Component) string {
+	b := new(strings.Builder)
+	require.NoError(t, h.Marshal(b, c))
+	return b.String()
+}
+
+func TestHTML_Marshal(t *testing.T) {
+	require.Equal(t,
+		`<span style="color:#ffaa00">Hello </span>`+
+			`<span style="color:#123456;font-weight:bold;text-decoration:underline">&lt;there&gt;</span>`+
+			`<span style="color:#ffaa00">!</span>`,
+		marshal(t, &HTML{}, txt))
+	require.Equal(t, "a &amp; b<br>c", marshal(t, &HTML{}, &Text{Content: "a & b\nc"}))
+}
+
+func TestHTML_Marshal_classes(t *testing.T) {
+	require.Equal(t,
+		`<span class="mc-gold">Hello </span>`+
+			`<span class="mc-bold mc-underlined" style="color:#123456">&lt;there&gt;</span>`+
+			`<span class="mc-gold">!</span>`,
+		marshal(t, &HTML{StyleMode: CSSClass}, txt))
+	require.Equal(t, `<span class="chat-dark_red chat-italic">x</span>`,
+		marshal(t, &HTML{StyleMode: CSSClass, ClassPrefix: "chat-"},
+			&Text{Content: "x", S: Style{Color: color.DarkRed, Italic: True}}))
+}
+
+func TestHTML_Marshal_events(t *testing.T) {
+	c := &Text{Content: "site", S: Style{
+		ClickEvent: OpenUrl("https://minekube.com/?a=1&b=2"),
+		HoverEvent: ShowText(&Text{Content: `Open "site"`, S: Style{Color: color.Red}}),
+	}}
+	require.Equal(t,
+		`<a href="https://minekube.com/?a=1&amp;b=2" target="_blank" rel="noopener noreferrer">`+
+			`<span title="Open &#34;site&#34;">site</span></a>`,
+		marshal(t, &HTML{}, c))
+
+	// only web links are rendered
+	require.Equal(t, "x", marshal(t, &HTML{}, &Text{Content: "x", S: Style{ClickEvent: OpenUrl("javascript:alert(1)")}}))
+	require.Equal(t, "x", marshal(t, &HTML{}, &Text{Content: "x", S: Style{ClickEvent: RunCommand("/help")}}))
+}
@@ -1,10 +1,13 @@
 package codec
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 
@@ -104,6 +107,20 @@ type Json struct {
 	// This setting is false by default to support older client versions.
 	// Set to true for compatibility with clients 1.20.5+.
 	EmitDefaultItemHoverQuantity bool
+	// Since Minecraft 1.19.4+ translation components can have a fallback format
+	// that is used if the client does not know the translation key.
+	// This setting decides whether to emit the fallback.
+	//
+	// This setting is false by default to support older client versions.
+	// Set to true for compatibility with clients 1.19.4+.
+	EmitTranslationFallback bool
+	// Since Minecraft 1.20.3+ translation arguments can be raw numbers and booleans.
+	// This setting decides whether to emit Primitive arguments as such,
+	// or as text components (legacy) instead.
+	//
+	// This setting is false by default to support older client versions.
+	// Set to true for compatibility with clients 1.20.3+.
+	EmitPrimitiveTranslationArguments bool
 	// How to emit the item data on show_item hover events.
 	// This controls whether to use legacy NBT, modern data components, or either based on the item.
 	//
@@ -114,6 +131,18 @@ type Json struct {
 	//
 	// This setting defaults to ShadowColorEmitModeNone for older client compatibility.
 	ShadowColorMode ShadowColorEmitMode
+	// Since Minecraft 1.21.9+ there are object components displaying atlas sprites and player heads inline.
+	// This setting decides whether to emit them, or to emit a text component with
+	// ObjectFallbackText in place of each object component instead.
+	//
+	// This setting is false by default to support older client versions.
+	// Set to true for compatibility with clients 1.21.9+.
+	EmitObjectComponent bool
+	// The text emitted in place of object components when EmitObjectComponent is false.
+	// The style and children of the object component are kept.
+	//
+	// This setting is empty by default.
+	ObjectFallbackText string
 	// Whether to use Go's standard json library for marshalling.
 	// It can be set to true if features such as key sorting in objects is needed
 	// (e.g. when testing to compare output).
@@ -168,8 +197,11 @@ var (
 		EmitHoverShowEntityKeyAsTypeAndUuidAsId: true,  // Legacy field names
 		ValidateStrictEvents:                    false, // No strict validation
 		EmitDefaultItemHoverQuantity:            false, // Don't emit count=1
+		EmitTranslationFallback:                 false, // No translation fallback
+		EmitPrimitiveTranslationArguments:       false, // Primitive arguments as text
 		ShowItemHoverDataMode:                   ShowItemHoverDataModeLegacyNBT,
 		ShadowColorMode:                         ShadowColorEmitModeNone,
+		EmitObjectComponent:                     false, // Object fallback text
 		StdJson:                                 true,
 	}
 
@@ -187,8 +219,11 @@ var (
 		EmitHoverShowEntityKeyAsTypeAndUuidAsId: true,  // Legacy field names
 		ValidateStrictEvents:                    false, // No strict validation
 		EmitDefaultItemHoverQuantity:            false, // Don't emit count=1
+		EmitTranslationFallback:                 false, // No translation fallback
+		EmitPrimitiveTranslationArguments:       false, // Primitive arguments as text
 		ShowItemHoverDataMode:                   ShowItemHoverDataModeLegacyNBT,
 		ShadowColorMode:                         ShadowColorEmitModeNone,
+		EmitObjectComponent:                     false, // Object fallback text
 		StdJson:                                 true,
 	}
 
@@ -206,8 +241,11 @@ var (
 		EmitHoverShowEntityKeyAsTypeAndUuidAsId: true,  // Legacy field names
 		ValidateStrictEvents:                    true,  // Strict validation
 		EmitDefaultItemHoverQuantity:            false, // Don't emit count=1
+		EmitTranslationFallback:                 true,  // Translation fallback
+		EmitPrimitiveTranslationArguments:       true,  // Primitive arguments
 		ShowItemHoverDataMode:                   ShowItemHoverDataModeLegacyNBT,
 		ShadowColorMode:                         ShadowColorEmitModeNone,
+		EmitObjectComponent:                     false, // Object fallback text
 		StdJson:                                 true,
 	}
 
@@ -226,8 +264,11 @@ var (
 		EmitHoverShowEntityKeyAsTypeAndUuidAsId: false, // Modern field names
 		ValidateStrictEvents:                    true,  // Strict validation
 		EmitDefaultItemHoverQuantity:            true,  // Emit count=1
+		EmitTranslationFallback:                 true,  // Translation fallback
+		EmitPrimitiveTranslationArguments:       true,  // Primitive arguments
 		ShowItemHoverDataMode:                   ShowItemHoverDataModeDataComponents,
 		ShadowColorMode:                         ShadowColorEmitModeInteger,
+		EmitObjectComponent:                     true, // Object components (1.21.9+)
 		StdJson:                                 true,
 	}
 
@@ -245,8 +286,11 @@ var (
 		EmitHoverShowEntityKeyAsTypeAndUuidAsId: false, // Modern field names
 		ValidateStrictEvents:                    true,  // Modern validation
 		EmitDefaultItemHoverQuantity:            true,  // Modern quantity emission
+		EmitTranslationFallback:                 true,  // Translation fallback
+		EmitPrimitiveTranslationArguments:       true,  // Primitive arguments
 		ShowItemHoverDataMode:                   ShowItemHoverDataModeDataComponents,
 		ShadowColorMode:                         ShadowColorEmitModeInteger,
+		EmitObjectComponent:                     true, // Object components (1.21.9+)
 		StdJson:                                 true,
 	}
 )
@@ -280,12 +324,62 @@ func (j *Json) Marshal(wr io.Writer, c Component) (err error) {
 
 func (j *Json) Unmarshal(data []byte) (Component, error) {
 	var i interface{}
-	if err := json.Unmarshal(data, &i); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // decode translation arguments losslessly
+	if err := dec.Decode(&i); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("codec.Json unmarshal: invalid data after top-level value")
+	}
+	i, err := floatNumbers(i)
+	if err != nil {
 		return nil, err
 	}
 	return j.decodeFromInterface(i)
 }
 
+// floatNumbers converts all json numbers to float64 as decoded by json.Unmarshal,
+// except for translation arguments which are kept as json.Number to decode them losslessly.
+func floatNumbers(i interface{}) (interface{}, error) {
+	var err error
+	switch t := i.(type) {
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("json: cannot unmarshal number %s into Go value of type float64", t)
+		}
+		return f, nil
+	case []interface{}:
+		for n, v := range t {
+			if t[n], err = floatNumbers(v); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for k, v := range t {
+			if with, ok := v.([]interface{}); ok && k == translateWith && t[translate] != nil {
+				for n, arg := range with {
+					if num, ok := arg.(json.Number); ok {
+						if _, err = floatNumbers(num); err != nil {
+							return nil, err
+						}
+						continue
+					}
+					if with[n], err = floatNumbers(arg); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			if t[k], err = floatNumbers(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return i, nil
+}
+
 // encode
 // encode
 // encode
@@ -302,6 +396,19 @@ func (j *Json) encode(o obj, c Component) (err error) {
 		return j.encodeText(o, t)
 	case *Translation:
 		return j.encodeTranslation(o, t)
+	case *Score:
+		return j.encodeScore(o, t)
+	case *Selector:
+		return j.encodeSelector(o, t)
+	case *Keybind:
+		return j.encodeKeybind(o, t)
+	case *NBT:
+		return j.encodeNBT(o, t)
+	case *Object:
+		return j.encodeObject(o, t)
+	case *Primitive:
+		o[text] = t.String()
+		return j.encodeComponent(o, t, extra)
 	default:
 		return fmt.Errorf("codec.Json marshal: unsupported component type %T", c)
 	}
@@ -312,12 +419,43 @@ const (
 	text  = "text"
 	extra = "extra"
 
-	translate     = "translate"
-	translateWith = "with"
+	translate         = "translate"
+	translateWith     = "with"
+	translateFallback = "fallback"
+
+	score          = "score"
+	scoreName      = "name"
+	scoreObjective = "objective"
+	scoreValue     = "value"
+
+	selector  = "selector"
+	separator = "separator"
+
+	keybind = "keybind"
+
+	nbtPath      = "nbt"
+	nbtInterpret = "interpret"
+	nbtBlock     = "block"
+	nbtEntity    = "entity"
+	nbtStorage   = "storage"
+
+	object       = "object"
+	objectAtlas  = "atlas"
+	objectSprite = "sprite"
+	objectPlayer = "player"
+	objectHat    = "hat"
 
-	font      = "font"
-	color     = "color"
-	insertion = "insertion"
+	profileName       = "name"
+	profileId         = "id"
+	profileProperties = "properties"
+	propertyName      = "name"
+	propertyValue     = "value"
+	propertySignature = "signature"
+
+	font        = "font"
+	color       = "color"
+	shadowColor = "shadow_color"
+	insertion   = "insertion"
 
 	// New format (1.21.5+): snake_case field names
 	clickEvent = "click_event"
@@ -380,9 +518,143 @@ func (j *Json) encodeTranslation(o obj, t *Translation) error {
 		return nil
 	}
 	o[translate] = t.Key
+	if t.Fallback != "" && j.EmitTranslationFallback {
+		o[translateFallback] = t.Fallback
+	}
 	return j.encodeComponent(o, t, translateWith)
 }
 
+func (j *Json) encodeScore(o obj, s *Score) error {
+	if s == nil {
+		return nil
+	}
+	scoreObj := obj{
+		scoreName:      s.Name,
+		scoreObjective: s.Objective,
+	}
+	if s.Value != "" {
+		scoreObj[scoreValue] = s.Value
+	}
+	o[score] = scoreObj
+	return j.encodeComponent(o, s, extra)
+}
+
+func (j *Json) encodeSelector(o obj, s *Selector) error {
+	if s == nil {
+		return nil
+	}
+	o[selector] = s.Pattern
+	if err := j.encodeSeparator(o, s.Separator); err != nil {
+		return err
+	}
+	return j.encodeComponent(o, s, extra)
+}
+
+func (j *Json) encodeKeybind(o obj, k *Keybind) error {
+	if k == nil {
+		return nil
+	}
+	o[keybind] = k.Key
+	return j.encodeComponent(o, k, extra)
+}
+
+func (j *Json) encodeNBT(o obj, n *NBT) error {
+	if n == nil {
+		return nil
+	}
+	o[nbtPath] = n.Path
+	if n.Interpret {
+		o[nbtInterpret] = true
+	}
+	switch src := n.Source.(type) {
+	case *BlockNBTSource:
+		o[nbtBlock] = src.Pos
+	case *EntityNBTSource:
+		o[nbtEntity] = src.Selector
+	case *StorageNBTSource:
+		o[nbtStorage] = src.Storage.String()
+	default:
+		return fmt.Errorf("codec.Json marshal: unsupported nbt component source %T", n.Source)
+	}
+	if err := j.encodeSeparator(o, n.Separator); err != nil {
+		return err
+	}
+	return j.encodeComponent(o, n, extra)
+}
+
+func (j *Json) encodeObject(o obj, ob *Object) error {
+	if ob == nil {
+		return nil
+	}
+	if !j.EmitObjectComponent {
+		o[text] = j.ObjectFallbackText
+		return j.encodeComponent(o, ob, extra)
+	}
+	switch c := ob.Contents.(type) {
+	case *AtlasSprite:
+		o[object] = objectAtlas
+		if c.Atlas != nil {
+			o[objectAtlas] = c.Atlas.String()
+		}
+		o[objectSprite] = c.Sprite.String()
+	case *PlayerHead:
+		o[object] = objectPlayer
+		o[objectPlayer] = j.encodeProfile(&c.Profile)
+		if c.NoHat {
+			o[objectHat] = false
+		}
+	default:
+		return fmt.Errorf("codec.Json marshal: unsupported object component contents %T", ob.Contents)
+	}
+	return j.encodeComponent(o, ob, extra)
+}
+
+func (j *Json) encodeProfile(p *PlayerProfile) obj {
+	o := obj{}
+	if p.Name != "" {
+		o[profileName] = p.Name
+	}
+	if p.Id != uuid.Nil {
+		o[profileId] = j.encodeUUIDIntArray(p.Id)
+	}
+	if len(p.Properties) != 0 {
+		props := make(arr, 0, len(p.Properties))
+		for _, prop := range p.Properties {
+			propObj := obj{
+				propertyName:  prop.Name,
+				propertyValue: prop.Value,
+			}
+			if prop.Signature != "" {
+				propObj[propertySignature] = prop.Signature
+			}
+			props = append(props, propObj)
+		}
+		o[profileProperties] = props
+	}
+	return o
+}
+
+// encodeUUIDIntArray encodes the UUID as four ints, most significant first.
+func (j *Json) encodeUUIDIntArray(id uuid.UUID) arr {
+	a := make(arr, 4)
+	for i := range a {
+		a[i] = int32(binary.BigEndian.Uint32(id[i*4:]))
+	}
+	return a
+}
+
+func (j *Json) encodeSeparator(o obj, sep Component) error {
+	if sep == nil {
+		return nil
+	}
+	sepObj := obj{}
+	if err := j.encode(sepObj, sep); err != nil {
+		return err
+	}
+	o[separator] = sepObj
+	return nil
+}
+
 func (j *Json) encodeComponent(o obj, c Component, childrenKey string) (err error) {
 	if c == nil {
 		return nil
@@ -392,6 +664,10 @@ func (j *Json) encodeComponent(o obj, c Component, childrenKey string) (err erro
 	}
 	var children arr
 	for _, child := range c.Children() {
+		if p, ok := child.(*Primitive); ok && childrenKey == translateWith && j.EmitPrimitiveTranslationArguments && p.S.IsZero() {
+			children = append(children, p.Value)
+			continue
+		}
 		childObj := obj{}
 		if err = j.encode(childObj, child); err != nil {
 			return err
@@ -414,6 +690,20 @@ func (j *Json) encodeStyle(o obj, s *Style) error {
 	if s.Color != nil {
 		o[color] = j.encodeColor(s.Color)
 	}
+	if s.ShadowColor != nil {
+		switch j.ShadowColorMode {
+		case ShadowColorEmitModeInteger:
+			o[shadowColor] = int32(*s.ShadowColor)
+		case ShadowColorEmitModeArray:
+			c := *s.ShadowColor
+			o[shadowColor] = arr{
+				float64(c.R()) / 255,
+				float64(c.G()) / 255,
+				float64(c.B()) / 255,
+				float64(c.A()) / 255,
+			}
+		}
+	}
 	for name := range Decorations {
 		state := s.Decoration(name)
 		if state != NotSet {
@@ -659,27 +949,37 @@ func (j *Json) decodeComponent(o obj) (c Component, err error) {
 	if o.Has(text) {
 		c = &Text{Content: fmt.Sprint(o[text])}
 	} else if o.Has(translate) {
-		k := fmt.Sprint(o[translate])
-		if o.Has(translateWith) {
-			with, ok := o[translateWith].([]interface{})
-			if !ok {
-				return nil, fmt.Errorf(`found invalid translate component, value of key %q is not an array`, with)
-			}
-			args := make([]Component, 0, len(with))
-			for _, arg := range with {
-				a, err := j.decodeFromInterface(arg)
-				if err != nil {
-					return nil, err
-				}
-				args = append(args, a)
-			}
-			c = &Translation{
-				Key:  k,
-				With: args,
-			}
-		} else {
-			c = &Translation{Key: k}
+		t, err := j.decodeTranslation(o)
+		if err != nil {
+			return nil, err
 		}
+		c = t
+	} else if o.Has(score) {
+		s, err := j.decodeScore(o[score])
+		if err != nil {
+			return nil, err
+		}
+		c = s
+	} else if o.Has(selector) {
+		sel := &Selector{Pattern: fmt.Sprint(o[selector])}
+		if sel.Separator, err = j.decodeSeparator(o); err != nil {
+			return nil, err
+		}
+		c = sel
+	} else if o.Has(keybind) {
+		c = &Keybind{Key: fmt.Sprint(o[keybind])}
+	} else if o.Has(nbtPath) {
+		n, err := j.decodeNBT(o)
+		if err != nil {
+			return nil, err
+		}
+		c = n
+	} else if o.Has(object) || o.Has(objectSprite) || o.Has(objectPlayer) {
+		ob, err := j.decodeObject(o)
+		if err != nil {
+			return nil, err
+		}
+		c = ob
 	} else {
 		c = &Text{}
 	}
@@ -708,6 +1008,215 @@ func (j *Json) decodeComponent(o obj) (c Component, err error) {
 	return c, nil
 }
 
+func (j *Json) decodeTranslation(o obj) (*Translation, error) {
+	t := &Translation{Key: fmt.Sprint(o[translate])}
+	if o.Has(translateWith) {
+		with, ok := o[translateWith].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf(`found invalid translate component, value of key %q is not an array`, translateWith)
+		}
+		t.With = make([]Component, 0, len(with))
+		for _, arg := range with {
+			if p := decodePrimitive(arg); p != nil {
+				t.With = append(t.With, p)
+				continue
+			}
+			a, err := j.decodeFromInterface(arg)
+			if err != nil {
+				return nil, err
+			}
+			t.With = append(t.With, a)
+		}
+	}
+	if o.Has(translateFallback) {
+		fallback, ok := o[translateFallback].(string)
+		if !ok {
+			return nil, fmt.Errorf(`value of key %q is not a string, but %T`, translateFallback, o[translateFallback])
+		}
+		t.Fallback = fallback
+	}
+	return t, nil
+}
+
+// may return nil in case i is not a primitive value
+func decodePrimitive(i interface{}) *Primitive {
+	switch t := i.(type) {
+	case bool:
+		return &Primitive{Value: t}
+	case json.Number:
+		if n, err := t.Int64(); err == nil {
+			return &Primitive{Value: n}
+		}
+		if f, err := t.Float64(); err == nil {
+			return &Primitive{Value: f}
+		}
+	case float64:
+		return &Primitive{Value: t}
+	}
+	return nil
+}
+
+func (j *Json) decodeScore(i interface{}) (*Score, error) {
+	o, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(`value of key %q is not a json object, but %T`, score, i)
+	}
+	s := &Score{}
+	for _, f := range []struct {
+		key string
+		dst *string
+	}{
+		{scoreName, &s.Name},
+		{scoreObjective, &s.Objective},
+		{scoreValue, &s.Value},
+	} {
+		v, ok := o[f.key]
+		if !ok {
+			continue
+		}
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf(`score component's value of key %q is not a string, but %T`, f.key, v)
+		}
+		*f.dst = str
+	}
+	if s.Name == "" || s.Objective == "" {
+		return nil, fmt.Errorf(`score component misses key %q or %q`, scoreName, scoreObjective)
+	}
+	return s, nil
+}
+
+func (j *Json) decodeNBT(o obj) (n *NBT, err error) {
+	n = &NBT{Path: fmt.Sprint(o[nbtPath])}
+	if o.Has(nbtInterpret) {
+		b, ok := o[nbtInterpret].(bool)
+		if !ok {
+			return nil, fmt.Errorf(`value of key %q is not a bool, but %T`, nbtInterpret, o[nbtInterpret])
+		}
+		n.Interpret = b
+	}
+	switch {
+	case o.Has(nbtBlock):
+		n.Source = &BlockNBTSource{Pos: fmt.Sprint(o[nbtBlock])}
+	case o.Has(nbtEntity):
+		n.Source = &EntityNBTSource{Selector: fmt.Sprint(o[nbtEntity])}
+	case o.Has(nbtStorage):
+		k, err := j.decodeKey(o[nbtStorage])
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding value of %q key: %v`, nbtStorage, err)
+		}
+		n.Source = &StorageNBTSource{Storage: k}
+	default:
+		return nil, fmt.Errorf(`nbt component misses one of the source keys %q, %q or %q`,
+			nbtBlock, nbtEntity, nbtStorage)
+	}
+	if n.Separator, err = j.decodeSeparator(o); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (j *Json) decodeObject(o obj) (*Object, error) {
+	typ := o.String(object)
+	if typ == "" {
+		// infer the object type from the present keys
+		typ = objectAtlas
+		if o.Has(objectPlayer) {
+			typ = objectPlayer
+		}
+	}
+	switch typ {
+	case objectAtlas:
+		var sprite AtlasSprite
+		if o.Has(objectAtlas) {
+			k, err := j.decodeResourceKey(o[objectAtlas])
+			if err != nil {
+				return nil, fmt.Errorf(`error decoding value of %q key: %v`, objectAtlas, err)
+			}
+			sprite.Atlas = k
+		}
+		if !o.Has(objectSprite) {
+			return nil, fmt.Errorf(`atlas object component misses key %q`, objectSprite)
+		}
+		k, err := j.decodeResourceKey(o[objectSprite])
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding value of %q key: %v`, objectSprite, err)
+		}
+		sprite.Sprite = k
+		return &Object{Contents: &sprite}, nil
+	case objectPlayer:
+		if !o.Has(objectPlayer) {
+			return nil, fmt.Errorf(`player object component misses key %q`, objectPlayer)
+		}
+		var head PlayerHead
+		profile, err := j.decodeProfile(o[objectPlayer])
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding value of %q key: %v`, objectPlayer, err)
+		}
+		head.Profile = *profile
+		if o.Has(objectHat) {
+			b, ok := o[objectHat].(bool)
+			if !ok {
+				return nil, fmt.Errorf(`value of key %q is not a bool, but %T`, objectHat, o[objectHat])
+			}
+			head.NoHat = !b
+		}
+		return &Object{Contents: &head}, nil
+	default:
+		return nil, fmt.Errorf(`unknown object component type %q`, typ)
+	}
+}
+
+func (j *Json) decodeProfile(i interface{}) (*PlayerProfile, error) {
+	var o obj
+	switch t := i.(type) {
+	case string:
+		return &PlayerProfile{Name: t}, nil
+	case map[string]interface{}:
+		o = t
+	default:
+		return nil, fmt.Errorf("must be a string or json object, but %T", i)
+	}
+	p := &PlayerProfile{Name: o.String(profileName)}
+	if o.Has(profileId) {
+		id, err := j.decodeUUID(o[profileId])
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding value of %q key: %v`, profileId, err)
+		}
+		p.Id = id
+	}
+	if o.Has(profileProperties) {
+		props, ok := o[profileProperties].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf(`value of key %q is not an array, but %T`, profileProperties, o[profileProperties])
+		}
+		for _, prop := range props {
+			propObj, ok := prop.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf(`profile property is not a json object, but %T`, prop)
+			}
+			p.Properties = append(p.Properties, ProfileProperty{
+				Name:      obj(propObj).String(propertyName),
+				Value:     obj(propObj).String(propertyValue),
+				Signature: obj(propObj).String(propertySignature),
+			})
+		}
+	}
+	return p, nil
+}
+
+// may return nil,nil in case the object has no separator
+func (j *Json) decodeSeparator(o obj) (Component, error) {
+	if !o.Has(separator) {
+		return nil, nil
+	}
+	sep, err := j.decodeFromInterface(o[separator])
+	if err != nil {
+		return nil, fmt.Errorf(`error decoding value of %q key: %w`, separator, err)
+	}
+	return sep, nil
+}
+
 func (j *Json) decodeStyle(o obj) (s *Style, err error) {
 	s = &Style{}
 	if o.Has(font) {
@@ -729,6 +1238,13 @@ func (j *Json) decodeStyle(o obj) (s *Style, err error) {
 			s.SetDecoration(*dec, True)
 		}
 	}
+	if o.Has(shadowColor) {
+		c, err := j.decodeShadowColor(o[shadowColor])
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding value of %q key: %v`, shadowColor, err)
+		}
+		s.ShadowColor = &c
+	}
 	for dec := range Decorations {
 		if o.Has(string(dec)) {
 			var b bool
@@ -1144,6 +1660,29 @@ func (j *Json) decodeColor(i interface{}) (c col.Color, dec *Decoration, reset b
 	return
 }
 
+func (j *Json) decodeShadowColor(i interface{}) (col.ARGB, error) {
+	switch t := i.(type) {
+	case float64:
+		// accept both signed and unsigned packed ints
+		return col.ARGB(uint32(int64(t))), nil
+	case []interface{}:
+		if len(t) != 4 {
+			return 0, fmt.Errorf("float array must have 4 elements, but has %d", len(t))
+		}
+		var rgba [4]uint32
+		for n, v := range t {
+			f, ok := v.(float64)
+			if !ok {
+				return 0, fmt.Errorf("float array element is not a number, but %T", v)
+			}
+			rgba[n] = uint32(math.Round(math.Max(0, math.Min(1, f)) * 255))
+		}
+		return col.ARGB(rgba[3]<<24 | rgba[0]<<16 | rgba[1]<<8 | rgba[2]), nil
+	default:
+		return 0, fmt.Errorf("must be a number or float array, but %T", i)
+	}
+}
+
 func (j *Json) decodeKey(i interface{}) (key.Key, error) {
 	if s, ok := i.(string); ok {
 		return key.ParseValid(s)
@@ -1151,11 +1690,33 @@ func (j *Json) decodeKey(i interface{}) (key.Key, error) {
 	return nil, errors.New("must be as string")
 }
 
-func (j *Json) decodeUUID(i interface{}) (uuid.UUID, error) {
-	if s, ok := i.(string); ok {
-		return uuid.Parse(s)
+// decodeResourceKey is like decodeKey but defaults to the minecraft namespace if none is given.
+func (j *Json) decodeResourceKey(i interface{}) (key.Key, error) {
+	if s, ok := i.(string); ok && !strings.Contains(s, ":") {
+		return key.Make(key.MinecraftNamespace, s)
+	}
+	return j.decodeKey(i)
+}
+
+func (j *Json) decodeUUID(i interface{}) (id uuid.UUID, err error) {
+	switch t := i.(type) {
+	case string:
+		return uuid.Parse(t)
+	case []interface{}:
+		// four ints, most significant first
+		if len(t) != 4 {
+			return id, fmt.Errorf("uuid int array must have 4 elements, but has %d", len(t))
+		}
+		for n, v := range t {
+			f, ok := v.(float64)
+			if !ok {
+				return id, fmt.Errorf("uuid int array element is not a number, but %T", v)
+			}
+			binary.BigEndian.PutUint32(id[n*4:], uint32(int32(f)))
+		}
+		return id, nil
 	}
-	return [16]byte{}, errors.New("must be as string")
+	return id, errors.New("must be as string or int array")
 }
 
 //
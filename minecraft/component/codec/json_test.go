@@ -746,3 +746,335 @@ func TestJSONOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestJson_score(t *testing.T) {
+	sc := &Score{
+		Name:      "@s",
+		Objective: "kills",
+		S:         Style{Color: Red.RGB, ClickEvent: RunCommand("/stats")},
+		Extra:     []Component{&Text{Content: " kills"}},
+	}
+
+	s := new(strings.Builder)
+	require.NoError(t, j1215Plus.Marshal(s, sc))
+	const exp = `{"click_event":{"action":"run_command","command":"/stats"},"color":"#ff5555","extra":[{"text":" kills"}],"score":{"name":"@s","objective":"kills"}}`
+	require.Equal(t, exp, s.String())
+
+	s.Reset()
+	require.NoError(t, jPre1215.Marshal(s, sc))
+	const expLegacy = `{"clickEvent":{"action":"run_command","value":"/stats"},"color":"#ff5555","extra":[{"text":" kills"}],"score":{"name":"@s","objective":"kills"}}`
+	require.Equal(t, expLegacy, s.String())
+
+	for _, in := range []string{exp, expLegacy} {
+		c, err := jCompat.Unmarshal([]byte(in))
+		require.NoError(t, err)
+		require.Equal(t, sc, c)
+	}
+
+	// pre-resolved value
+	c, err := jCompat.Unmarshal([]byte(`{"score":{"name":"Notch","objective":"deaths","value":"3"}}`))
+	require.NoError(t, err)
+	require.Equal(t, &Score{Name: "Notch", Objective: "deaths", Value: "3"}, c)
+
+	s.Reset()
+	require.NoError(t, jCompat.Marshal(s, c))
+	require.Equal(t, `{"score":{"name":"Notch","objective":"deaths","value":"3"}}`, s.String())
+
+	_, err = jCompat.Unmarshal([]byte(`{"score":{"name":"Notch"}}`))
+	require.Error(t, err)
+	_, err = jCompat.Unmarshal([]byte(`{"score":"Notch"}`))
+	require.Error(t, err)
+}
+
+func TestJson_selector(t *testing.T) {
+	sel := &Selector{
+		Pattern:   "@a[distance=..5]",
+		Separator: &Text{Content: " | ", S: Style{Color: Gray.RGB}},
+		S:         Style{Bold: True},
+	}
+
+	s := new(strings.Builder)
+	require.NoError(t, j1215Plus.Marshal(s, sel))
+	const exp = `{"bold":true,"selector":"@a[distance=..5]","separator":{"color":"#aaaaaa","text":" | "}}`
+	require.Equal(t, exp, s.String())
+
+	c, err := jCompat.Unmarshal([]byte(exp))
+	require.NoError(t, err)
+	require.Equal(t, sel, c)
+
+	c, err = jCompat.Unmarshal([]byte(`{"selector":"@p","separator":", "}`))
+	require.NoError(t, err)
+	require.Equal(t, &Selector{Pattern: "@p", Separator: &Text{Content: ", "}}, c)
+
+	c, err = jCompat.Unmarshal([]byte(`{"selector":"@e[type=cow]"}`))
+	require.NoError(t, err)
+	require.Equal(t, &Selector{Pattern: "@e[type=cow]"}, c)
+}
+
+func TestJson_keybind(t *testing.T) {
+	kb := &Keybind{
+		Key:   "key.jump",
+		S:     Style{Color: Gold.RGB},
+		Extra: []Component{&Text{Content: " to jump"}},
+	}
+
+	s := new(strings.Builder)
+	require.NoError(t, j1215Plus.Marshal(s, kb))
+	const exp = `{"color":"#ffaa00","extra":[{"text":" to jump"}],"keybind":"key.jump"}`
+	require.Equal(t, exp, s.String())
+
+	c, err := jCompat.Unmarshal([]byte(exp))
+	require.NoError(t, err)
+	require.Equal(t, kb, c)
+}
+
+func TestJson_nbt(t *testing.T) {
+	storage, err := key.Parse("minecraft:quests")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		nbt  *NBT
+		json string
+	}{
+		{
+			name: "entity",
+			nbt: &NBT{
+				Path:      "Inventory[0]",
+				Interpret: true,
+				Separator: &Text{Content: ", "},
+				Source:    &EntityNBTSource{Selector: "@p"},
+			},
+			json: `{"entity":"@p","interpret":true,"nbt":"Inventory[0]","separator":{"text":", "}}`,
+		},
+		{
+			name: "block",
+			nbt: &NBT{
+				Path:   "Items[0].id",
+				Source: &BlockNBTSource{Pos: "~ ~-1 ~"},
+				S:      Style{Italic: False},
+			},
+			json: `{"block":"~ ~-1 ~","italic":false,"nbt":"Items[0].id"}`,
+		},
+		{
+			name: "storage",
+			nbt: &NBT{
+				Path:   "current.title",
+				Source: &StorageNBTSource{Storage: storage},
+				Extra:  []Component{&Text{Content: "!"}},
+			},
+			json: `{"extra":[{"text":"!"}],"nbt":"current.title","storage":"minecraft:quests"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, j := range []*Json{JsonPre1_16, JsonPre1_20_3, JsonPre1_21_5, JsonModern, JsonUniversal} {
+				s := new(strings.Builder)
+				require.NoError(t, j.Marshal(s, tc.nbt))
+				require.Equal(t, tc.json, s.String())
+
+				c, err := j.Unmarshal([]byte(s.String()))
+				require.NoError(t, err)
+				require.Equal(t, tc.nbt, c)
+			}
+		})
+	}
+
+	_, err = jCompat.Unmarshal([]byte(`{"nbt":"Inventory[0]"}`))
+	require.Error(t, err)
+	_, err = jCompat.Unmarshal([]byte(`{"nbt":"a","storage":"Not A Key"}`))
+	require.Error(t, err)
+}
+
+func TestJson_object(t *testing.T) {
+	atlas := &Object{
+		Contents: &AtlasSprite{
+			Atlas:  key.New(key.MinecraftNamespace, "blocks"),
+			Sprite: key.New(key.MinecraftNamespace, "block/stone"),
+		},
+		S: Style{Italic: False},
+	}
+	head := &Object{
+		Contents: &PlayerHead{
+			Profile: PlayerProfile{
+				Name: "Notch",
+				Id:   uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5"),
+				Properties: []ProfileProperty{
+					{Name: "textures", Value: "e3RleHR1cmVzOnt9fQ=="},
+				},
+			},
+			NoHat: true,
+		},
+	}
+
+	t.Run("atlas", func(t *testing.T) {
+		s := new(strings.Builder)
+		require.NoError(t, JsonModern.Marshal(s, atlas))
+		const exp = `{"atlas":"minecraft:blocks","italic":false,"object":"atlas","sprite":"minecraft:block/stone"}`
+		require.Equal(t, exp, s.String())
+
+		c, err := jCompat.Unmarshal([]byte(exp))
+		require.NoError(t, err)
+		require.Equal(t, atlas, c)
+
+		// namespace and atlas are optional
+		c, err = jCompat.Unmarshal([]byte(`{"object":"atlas","sprite":"block/stone"}`))
+		require.NoError(t, err)
+		require.Equal(t, &Object{Contents: &AtlasSprite{
+			Sprite: key.New(key.MinecraftNamespace, "block/stone"),
+		}}, c)
+	})
+
+	t.Run("player", func(t *testing.T) {
+		s := new(strings.Builder)
+		require.NoError(t, JsonModern.Marshal(s, head))
+		const exp = `{"hat":false,"object":"player","player":{"id":[110787060,1156138790,-1514210135,238594805],"name":"Notch","properties":[{"name":"textures","value":"e3RleHR1cmVzOnt9fQ=="}]}}`
+		require.Equal(t, exp, s.String())
+
+		c, err := jCompat.Unmarshal([]byte(exp))
+		require.NoError(t, err)
+		require.Equal(t, head, c)
+
+		c, err = jCompat.Unmarshal([]byte(`{"player":"Notch"}`))
+		require.NoError(t, err)
+		require.Equal(t, &Object{Contents: &PlayerHead{Profile: PlayerProfile{Name: "Notch"}}}, c)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		s := new(strings.Builder)
+		require.NoError(t, JsonPre1_21_5.Marshal(s, atlas))
+		require.Equal(t, `{"italic":false,"text":""}`, s.String())
+
+		j := *JsonPre1_21_5
+		j.ObjectFallbackText = "[icon]"
+		s.Reset()
+		require.NoError(t, j.Marshal(s, &Text{Content: "Lobby ", Extra: []Component{head}}))
+		require.Equal(t, `{"extra":[{"text":"[icon]"}],"text":"Lobby "}`, s.String())
+	})
+
+	_, err := jCompat.Unmarshal([]byte(`{"object":"atlas"}`))
+	require.Error(t, err)
+	_, err = jCompat.Unmarshal([]byte(`{"object":"unknown"}`))
+	require.Error(t, err)
+}
+
+func TestJson_shadowColor(t *testing.T) {
+	shadow := MakeARGB(HexInt(0xff5555), 0xff)
+	tx := &Text{Content: "Shadow", S: Style{ShadowColor: &shadow}}
+
+	testCases := []struct {
+		mode ShadowColorEmitMode
+		json string
+	}{
+		{ShadowColorEmitModeNone, `{"text":"Shadow"}`},
+		{ShadowColorEmitModeInteger, `{"shadow_color":-43691,"text":"Shadow"}`},
+		{ShadowColorEmitModeArray, `{"shadow_color":[1,0.3333333333333333,0.3333333333333333,1],"text":"Shadow"}`},
+	}
+	for _, tc := range testCases {
+		j := *jCompat
+		j.ShadowColorMode = tc.mode
+
+		s := new(strings.Builder)
+		require.NoError(t, j.Marshal(s, tx))
+		require.Equal(t, tc.json, s.String())
+
+		if tc.mode != ShadowColorEmitModeNone {
+			c, err := j.Unmarshal([]byte(s.String()))
+			require.NoError(t, err)
+			require.Equal(t, tx, c)
+		}
+	}
+
+	// unsigned packed int
+	c, err := jCompat.Unmarshal([]byte(`{"shadow_color":4294923605,"text":"Shadow"}`))
+	require.NoError(t, err)
+	require.Equal(t, tx, c)
+
+	_, err = jCompat.Unmarshal([]byte(`{"shadow_color":[1,0,0],"text":"Shadow"}`))
+	require.Error(t, err)
+}
+
+func TestJson_translationFallback(t *testing.T) {
+	tr := &Translation{
+		Key:      "lobby.welcome",
+		Fallback: "Welcome, %s!",
+		With:     []Component{&Text{Content: "Steve"}},
+	}
+
+	s := new(strings.Builder)
+	require.NoError(t, JsonModern.Marshal(s, tr))
+	const exp = `{"fallback":"Welcome, %s!","translate":"lobby.welcome","with":[{"text":"Steve"}]}`
+	require.Equal(t, exp, s.String())
+
+	c, err := JsonPre1_16.Unmarshal([]byte(exp))
+	require.NoError(t, err)
+	require.Equal(t, tr, c)
+
+	s.Reset()
+	require.NoError(t, JsonPre1_20_3.Marshal(s, tr))
+	require.Equal(t, `{"translate":"lobby.welcome","with":[{"text":"Steve"}]}`, s.String())
+
+	_, err = jCompat.Unmarshal([]byte(`{"translate":"a","fallback":1}`))
+	require.Error(t, err)
+}
+
+func TestJson_primitiveTranslationArguments(t *testing.T) {
+	tr := &Translation{
+		Key: "stats.line",
+		With: []Component{
+			&Text{Content: "kills"},
+			&Primitive{Value: int64(9007199254740993)},
+			&Primitive{Value: 0.5},
+			&Primitive{Value: true},
+		},
+	}
+
+	s := new(strings.Builder)
+	require.NoError(t, JsonModern.Marshal(s, tr))
+	const exp = `{"translate":"stats.line","with":[{"text":"kills"},9007199254740993,0.5,true]}`
+	require.Equal(t, exp, s.String())
+
+	c, err := jCompat.Unmarshal([]byte(exp))
+	require.NoError(t, err)
+	require.Equal(t, tr, c)
+
+	s.Reset()
+	require.NoError(t, JsonPre1_20_3.Marshal(s, tr))
+	require.Equal(t, `{"translate":"stats.line","with":[{"text":"kills"},{"text":"9007199254740993"},{"text":"0.5"},{"text":"true"}]}`, s.String())
+
+	// gojay encodes primitives the same way
+	j := *JsonModern
+	j.StdJson = false
+	s.Reset()
+	require.NoError(t, j.Marshal(s, tr))
+	c, err = jCompat.Unmarshal([]byte(s.String()))
+	require.NoError(t, err)
+	require.Equal(t, tr, c)
+
+	_, err = jCompat.Unmarshal([]byte(`{"text":"a"}}`))
+	require.Error(t, err)
+
+	// styled primitives are encoded as text
+	s.Reset()
+	require.NoError(t, JsonModern.Marshal(s, &Translation{Key: "a", With: []Component{
+		&Primitive{Value: int64(3), S: Style{Bold: True}},
+	}}))
+	require.Equal(t, `{"translate":"a","with":[{"bold":true,"text":"3"}]}`, s.String())
+}
+
+func TestJson_Unmarshal_numbers(t *testing.T) {
+	// numbers outside of translation arguments decode as before
+	c, err := jCompat.Unmarshal([]byte(`{"text":1.0,"extra":[{"text":1e21}]}`))
+	require.NoError(t, err)
+	require.Equal(t, &Text{Content: "1", Extra: []Component{&Text{Content: "1e+21"}}}, c)
+
+	c, err = jCompat.Unmarshal([]byte(`{"translate":"a","with":[{"text":2.50}]}`))
+	require.NoError(t, err)
+	require.Equal(t, &Translation{Key: "a", With: []Component{&Text{Content: "2.5"}}}, c)
+
+	_, err = jCompat.Unmarshal([]byte(`{"translate":"a","with":[1e400]}`))
+	require.EqualError(t, err, "json: cannot unmarshal number 1e400 into Go value of type float64")
+	_, err = jCompat.Unmarshal([]byte(`{"text":"a","extra":[{"text":1e400}]}`))
+	require.EqualError(t, err, "json: cannot unmarshal number 1e400 into Go value of type float64")
+}
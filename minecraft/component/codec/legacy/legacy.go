@@ -23,6 +23,10 @@ type Legacy struct {
 
 	// Whether to add a "open_url" click event with the URL to the text containing an URL.
 	ClickableUrl bool
+
+	// The optional Translator to render Translation components with.
+	// Translations it does not know are rendered using their fallback or key.
+	Translator Translator
 }
 
 var _ codec.Codec = (*Legacy)(nil)
@@ -50,9 +54,17 @@ func (l *Legacy) Marshal(wr io.Writer, c Component) error {
 	if l.HexChar == 0 {
 		l.HexChar = DefaultHexChar
 	}
+	f := &Flattener{Translator: l.Translator}
+	runs, err := f.Runs(c)
+	if err != nil {
+		return err
+	}
 	s := newStringBuilder(l, l.Char)
-	s.append(c, &style{b: s, decorations: map[Decoration]struct{}{}})
-	_, err := wr.Write([]byte(s.String()))
+	for _, run := range runs {
+		s.styleOf(&run.Style).applyFormat()
+		_, _ = s.WriteString(run.Text)
+	}
+	_, err = wr.Write([]byte(s.String()))
 	return err
 }
 
@@ -103,28 +115,16 @@ func newStringBuilder(l *Legacy, char rune) *stringBuilder {
 	return b
 }
 
-func (b *stringBuilder) append(c Component, s *style) {
-	if c == nil {
-		return
-	}
-	s.apply(c)
-
-	if t, ok := c.(*Text); ok && len(t.Content) != 0 {
-		s.applyFormat()
-		_, _ = b.WriteString(t.Content)
-	}
-
-	if len(c.Children()) == 0 {
-		return
-	}
-	childrenStyle := s.copy()
-	for _, child := range c.Children() {
-		if child == nil {
-			continue
+// styleOf returns the legacy style format of an effective Style.
+func (b *stringBuilder) styleOf(effective *Style) *style {
+	s := newStyle(b)
+	s.color = effective.Color
+	for _, d := range DecorationsOrder {
+		if effective.Decoration(d) == True {
+			s.decorations[d] = struct{}{}
 		}
-		b.append(child, childrenStyle)
-		childrenStyle.set(s)
 	}
+	return s
 }
 
 func (b *stringBuilder) appendFormat(format Format) {
@@ -190,36 +190,6 @@ func (d decorations) addAll(d2 decorations) {
 	}
 }
 
-func (s *style) copy() *style {
-	return &style{
-		b:     s.b,
-		color: s.color,
-		decorations: func() decorations {
-			m := make(decorations, len(s.decorations))
-			for d := range s.decorations {
-				m[d] = struct{}{}
-			}
-			return m
-		}(),
-	}
-}
-
-func (s *style) apply(c Component) {
-	color := c.Style().Color
-	if color != nil {
-		s.color = color
-	}
-
-	for d := range Decorations {
-		switch c.Style().Decoration(d) {
-		case True:
-			s.decorations[d] = struct{}{}
-		case False:
-			delete(s.decorations, d)
-		}
-	}
-}
-
 func (s *style) applyFormat() {
 	// If color changes, we need to do a full reset
 	if s.color != s.b.style.color {
@@ -244,12 +214,6 @@ func (s *style) applyFormat() {
 	}
 }
 
-func (s *style) set(s2 *style) {
-	s.color = s2.color
-	s.decorations.clear()
-	s.decorations.addAll(s2.decorations)
-}
-
 func (s *style) applyFullFormat() {
 	if s.color != nil {
 		s.b.appendFormat(s.color)
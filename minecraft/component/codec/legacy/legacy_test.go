@@ -74,3 +74,45 @@ func TestLegacy_Unmarshal(t *testing.T) {
 		"§b§k§nHello§c§o§n there!",
 	}, b.String(), "%q invalid", b.String())
 }
+
+func TestLegacy_Marshal_selector(t *testing.T) {
+	b := new(strings.Builder)
+	err := l.Marshal(b, &Text{
+		Content: "Near: ",
+		Extra:   []Component{&Selector{Pattern: "@p", S: Style{Color: Red}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Near: §c@p", b.String())
+}
+
+func TestLegacy_Marshal_keybind(t *testing.T) {
+	b := new(strings.Builder)
+	err := l.Marshal(b, &Text{
+		Content: "Press ",
+		Extra:   []Component{&Keybind{Key: "key.sneak", S: Style{Color: Gold}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Press §6Left Shift", b.String())
+}
+
+func TestLegacy_Marshal_translation(t *testing.T) {
+	b := new(strings.Builder)
+	err := l.Marshal(b, &Translation{
+		Key:      "lobby.welcome",
+		Fallback: "Welcome, %s!",
+		S:        Style{Color: Gold},
+		With:     []Component{&Text{Content: "Steve", S: Style{Color: Red}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "§6Welcome, §cSteve§6!", b.String())
+}
+
+func TestLegacy_Marshal_score(t *testing.T) {
+	b := new(strings.Builder)
+	err := l.Marshal(b, &Text{
+		Content: "Kills: ",
+		Extra:   []Component{&Score{Name: "@p", Objective: "kills", Value: "12", S: Style{Color: Red}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Kills: §c12", b.String())
+}
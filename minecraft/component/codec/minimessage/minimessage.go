@@ -0,0 +1,481 @@
+package minimessage
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+)
+
+// MiniMessage is a codec for the MiniMessage format, a string representation
+// of components using HTML-like tags (e.g. "<red>Hello <bold>world</bold></red>").
+//
+// See https://docs.advntr.dev/minimessage/format.html for the format.
+// Supported are colors, decorations and the click, hover, insert, font, shadow,
+// lang, lang_or, key, selector, score, newline and reset tags.
+//
+// Further tags, e.g. placeholders, are added with TagResolver.
+//
+// Unknown and invalid tags are kept as text. A literal "<" is escaped as "\<"
+// and a literal backslash in front of it as "\\". Quoted tag arguments escape
+// the quote and backslash characters with a backslash.
+type MiniMessage struct {
+	// Strict makes Unmarshal fail on tags that are not closed, that are closed
+	// in the wrong order or that are closed without being opened.
+	// By default such tags are closed implicitly or ignored.
+	//
+	// Self-closing tags, e.g. "<lang:...>", and "<reset>" are allowed in strict mode.
+	Strict bool
+	// Tags are the tags available when parsing, nil uses StandardTags.
+	// To restrict the tags user input may use, set e.g. Resolvers(ColorTags, DecorationTags).
+	Tags TagResolver
+}
+
+var _ codec.Codec = (*MiniMessage)(nil)
+
+// Marshal encodes the component tree rooted at c as MiniMessage.
+func (m *MiniMessage) Marshal(wr io.Writer, c Component) error {
+	b := new(strings.Builder)
+	if err := m.encode(b, c); err != nil {
+		return err
+	}
+	_, err := wr.Write([]byte(b.String()))
+	return err
+}
+
+// MarshalString returns the MiniMessage of c.
+func (m *MiniMessage) MarshalString(c Component) (string, error) {
+	b := new(strings.Builder)
+	err := m.encode(b, c)
+	return b.String(), err
+}
+
+// Unmarshal parses the MiniMessage into a component.
+func (m *MiniMessage) Unmarshal(data []byte) (Component, error) {
+	return m.UnmarshalString(string(data))
+}
+
+// UnmarshalString parses the MiniMessage into a component.
+// The resolvers, e.g. placeholders, take precedence over the Tags.
+func (m *MiniMessage) UnmarshalString(s string, resolvers ...TagResolver) (Component, error) {
+	tags := m.Tags
+	if tags == nil {
+		tags = StandardTags
+	}
+	if len(resolvers) != 0 {
+		tags = Resolvers(append(append([]TagResolver(nil), resolvers...), tags)...)
+	}
+	p := &parser{m: m, tags: tags}
+	return p.parse(s)
+}
+
+// Escape escapes all characters in s that would otherwise be parsed as MiniMessage tags.
+func Escape(s string) string {
+	if !strings.ContainsAny(s, `<\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '<' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// quote returns the tag argument s, quoted if needed.
+func quote(s string) string {
+	if s != "" && strings.TrimSpace(s) == s && !strings.ContainsAny(s, `:'"<>\`) {
+		return s
+	}
+	var b strings.Builder
+	b.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\'' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// unquote returns the unquoted tag argument s.
+func unquote(s string) string {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return s
+	}
+	q := s[0]
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && (s[i+1] == q || s[i+1] == '\\') {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// tagEnd returns the index of the '>' ending the tag starting at the '<' at index start.
+// Arguments may be quoted and contain '<' and '>'.
+func tagEnd(s string, start int) (int, bool) {
+	var q byte
+	for i := start + 1; i < len(s); i++ {
+		c := s[i]
+		if q != 0 {
+			if c == '\\' && i+1 < len(s) {
+				i++
+			} else if c == q {
+				q = 0
+			}
+			continue
+		}
+		switch c {
+		case '\'', '"':
+			if s[i-1] == ':' {
+				q = c
+			}
+		case '>':
+			return i, true
+		case '<':
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// splitArgs splits the tag content at every colon that is not quoted and unquotes the parts.
+func splitArgs(s string) []string {
+	var (
+		parts []string
+		start int
+		q     byte
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if q != 0 {
+			if c == '\\' && i+1 < len(s) {
+				i++
+			} else if c == q {
+				q = 0
+			}
+			continue
+		}
+		switch c {
+		case '\'', '"':
+			if i == start {
+				q = c
+			}
+		case ':':
+			parts = append(parts, unquote(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, unquote(s[start:]))
+}
+
+// frame is an open styling tag.
+type frame struct {
+	name string // canonical name to close the tag with
+	node *Text
+}
+
+// maxDepth is the maximum depth of MiniMessage nested in tag arguments.
+const maxDepth = 16
+
+type parser struct {
+	m     *MiniMessage
+	tags  TagResolver
+	depth int // depth of nested parsing
+
+	root  *Text
+	stack []frame
+	text  strings.Builder
+}
+
+func (p *parser) parse(s string) (Component, error) {
+	p.root = &Text{}
+	if err := p.parseTo(s); err != nil {
+		return nil, err
+	}
+	return Compact(p.root), nil
+}
+
+func (p *parser) parseTo(s string) error {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '<' || s[i+1] == '\\'):
+			p.text.WriteByte(s[i+1])
+			i += 2
+		case c == '<':
+			end, ok := tagEnd(s, i)
+			if !ok {
+				p.text.WriteByte(c)
+				i++
+				continue
+			}
+			handled, err := p.tag(s[i+1 : end])
+			if err != nil {
+				return err
+			}
+			if !handled {
+				p.text.WriteString(s[i : end+1])
+			}
+			i = end + 1
+		default:
+			p.text.WriteByte(c)
+			i++
+		}
+	}
+	p.flush()
+	if p.m.Strict && len(p.stack) != 0 {
+		return fmt.Errorf("unclosed tag <%s>", p.stack[len(p.stack)-1].name)
+	}
+	return nil
+}
+
+// current returns the component new content is appended to.
+func (p *parser) current() *Text {
+	if len(p.stack) == 0 {
+		return p.root
+	}
+	return p.stack[len(p.stack)-1].node
+}
+
+// flush appends the pending text.
+func (p *parser) flush() {
+	if p.text.Len() == 0 {
+		return
+	}
+	cur := p.current()
+	cur.Extra = append(cur.Extra, &Text{Content: p.text.String()})
+	p.text.Reset()
+}
+
+// tag handles the tag content between '<' and '>' and reports whether it is a valid tag.
+func (p *parser) tag(content string) (bool, error) {
+	if strings.HasPrefix(content, "/") {
+		return p.close(content[1:])
+	}
+	selfClosing := strings.HasSuffix(content, "/")
+	content = strings.TrimSuffix(content, "/")
+	args := splitArgs(content)
+	name := strings.ToLower(args[0])
+	if name == "" || !p.tags.Has(name) {
+		return false, nil
+	}
+	t, err := p.tags.Resolve(name, args[1:], &Context{p: p})
+	if err != nil || t == nil {
+		return false, err
+	}
+
+	p.flush()
+	switch {
+	case t.reset:
+		p.stack = p.stack[:0]
+	case t.insert != nil:
+		cur := p.current()
+		cur.Extra = append(cur.Extra, Clone(t.insert))
+	case t.style != nil && selfClosing:
+		// opened and closed at once, styling no content
+	case t.style != nil:
+		node := &Text{}
+		t.style(&node.S)
+		cur := p.current()
+		cur.Extra = append(cur.Extra, node)
+		p.stack = append(p.stack, frame{name: canonical(name), node: node})
+	}
+	return true, nil
+}
+
+// close handles the closing tag content after "</".
+func (p *parser) close(content string) (bool, error) {
+	name := strings.ToLower(splitArgs(content)[0])
+	if name == "" || !p.tags.Has(name) {
+		return false, nil
+	}
+	name = canonical(name)
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		if p.stack[i].name != name {
+			continue
+		}
+		if p.m.Strict && i != len(p.stack)-1 {
+			return false, fmt.Errorf("tag </%s> closed before tag </%s>", name, p.stack[len(p.stack)-1].name)
+		}
+		p.flush()
+		p.stack = p.stack[:i]
+		return true, nil
+	}
+	if p.m.Strict {
+		return false, fmt.Errorf("closing tag </%s> without opening tag", name)
+	}
+	return true, nil
+}
+
+// sub parses a tag argument containing MiniMessage.
+func (p *parser) sub(s string) (Component, error) {
+	if p.depth == maxDepth {
+		return nil, fmt.Errorf("maximum nesting depth of %d exceeded", maxDepth)
+	}
+	sub := &parser{m: p.m, tags: p.tags, depth: p.depth + 1}
+	return sub.parse(s)
+}
+
+// encode
+
+func (m *MiniMessage) encode(b *strings.Builder, c Component) error {
+	if c == nil {
+		return nil
+	}
+	closers, err := m.openStyle(b, c.Style())
+	if err != nil {
+		return err
+	}
+
+	switch t := c.(type) {
+	case *Text:
+		b.WriteString(Escape(t.Content))
+	case *Primitive:
+		b.WriteString(Escape(t.String()))
+	case *Translation:
+		args := []string{quote(t.Key)}
+		name := "lang"
+		if t.Fallback != "" {
+			name = "lang_or"
+			args = append(args, quote(t.Fallback))
+		}
+		for _, arg := range t.With {
+			s, err := m.MarshalString(arg)
+			if err != nil {
+				return err
+			}
+			args = append(args, quote(s))
+		}
+		b.WriteString("<" + name + ":" + strings.Join(args, ":") + ">")
+	case *Keybind:
+		b.WriteString("<key:" + quote(t.Key) + ">")
+	case *Selector:
+		b.WriteString("<selector:" + quote(t.Pattern))
+		if t.Separator != nil {
+			s, err := m.MarshalString(t.Separator)
+			if err != nil {
+				return err
+			}
+			b.WriteString(":" + quote(s))
+		}
+		b.WriteString(">")
+	case *Score:
+		b.WriteString("<score:" + quote(t.Name) + ":" + quote(t.Objective) + ">")
+	default:
+		return fmt.Errorf("unsupported component type %T", c)
+	}
+
+	if _, ok := c.(*Translation); !ok {
+		// the children of a translation are its arguments
+		for _, child := range c.Children() {
+			if err = m.encode(b, child); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(closers) - 1; i >= 0; i-- {
+		b.WriteString("</" + closers[i] + ">")
+	}
+	return nil
+}
+
+// openStyle writes the opening tags of the style and returns the names to close them with.
+func (m *MiniMessage) openStyle(b *strings.Builder, s *Style) (closers []string, err error) {
+	open := func(name string, args ...string) {
+		b.WriteString("<" + name)
+		for _, arg := range args {
+			b.WriteString(":" + arg)
+		}
+		b.WriteString(">")
+		closers = append(closers, name)
+	}
+
+	if s.Color != nil {
+		if n, ok := s.Color.(*color.Named); ok {
+			open(n.Name)
+		} else {
+			open(s.Color.Hex())
+		}
+	}
+	if s.ShadowColor != nil {
+		c := *s.ShadowColor
+		open("shadow", fmt.Sprintf("#%02x%02x%02x%02x", c.R(), c.G(), c.B(), c.A()))
+	}
+	for _, d := range DecorationsOrder {
+		switch s.Decoration(d) {
+		case True:
+			open(string(d))
+		case False:
+			open("!" + string(d))
+		}
+	}
+	if s.Font != nil {
+		open("font", s.Font.String())
+	}
+	if s.Insertion != nil {
+		open("insert", quote(*s.Insertion))
+	}
+	if s.ClickEvent != nil {
+		open("click", s.ClickEvent.Action().Name(), quote(s.ClickEvent.Value()))
+	}
+	if s.HoverEvent != nil {
+		args, err := m.hoverArgs(s.HoverEvent)
+		if err != nil {
+			return nil, err
+		}
+		if args != nil {
+			open("hover", args...)
+		}
+	}
+	return closers, nil
+}
+
+// hoverArgs returns the arguments of the hover tag or nil if the event is not supported.
+func (m *MiniMessage) hoverArgs(e HoverEvent) ([]string, error) {
+	args := []string{e.Action().Name()}
+	switch v := e.Value().(type) {
+	case Component:
+		s, err := m.MarshalString(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(args, quote(s)), nil
+	case *ShowItemHoverType:
+		if v.Item == nil {
+			return nil, nil
+		}
+		args = append(args, quote(v.Item.String()))
+		if v.Count > 1 {
+			args = append(args, strconv.Itoa(v.Count))
+		}
+		return args, nil
+	case *ShowEntityHoverType:
+		if v.Type == nil {
+			return nil, nil
+		}
+		args = append(args, quote(v.Type.String()), v.Id.String())
+		if v.Name != nil {
+			s, err := m.MarshalString(v.Name)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, quote(s))
+		}
+		return args, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,146 @@
+package minimessage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+var m = &MiniMessage{}
+
+func TestMiniMessage_Unmarshal(t *testing.T) {
+	c, err := m.UnmarshalString("<red>Hello <bold>world</bold>!</red> bye")
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "Hello ", S: Style{Color: color.Red}, Extra: []Component{
+			&Text{Content: "world", S: Style{Bold: True}},
+			&Text{Content: "!"},
+		}},
+		&Text{Content: " bye"},
+	}}, c), "%#v", c)
+
+	for input, expected := range map[string]Component{
+		"":                      &Text{},
+		"plain":                 &Text{Content: "plain"},
+		"<#ff5555>hex":          &Text{Content: "hex", S: Style{Color: color.Red.RGB}},
+		"<color:grey>grey":      &Text{Content: "grey", S: Style{Color: color.Gray}},
+		"<b><!i>x":              &Text{Content: "x", S: Style{Bold: True, Italic: False}},
+		"<italic:false>x":       &Text{Content: "x", S: Style{Italic: False}},
+		"<unknown>x</unknown>":  &Text{Content: "<unknown>x</unknown>"},
+		"<color:nope>x":         &Text{Content: "<color:nope>x"},
+		`\<red>x \\ \y`:         &Text{Content: `<red>x \ \y`},
+		"a < b > c":             &Text{Content: "a < b > c"},
+		"<font:uniform>f":       &Text{Content: "f", S: Style{Font: UniformFont}},
+		"<font:minecraft:alt>f": &Text{Content: "f", S: Style{Font: AltFont}},
+		"<key:key.jump>":        &Keybind{Key: "key.jump"},
+		"<newline>":             &Text{Content: "\n"},
+		"<selector:@p>":         &Selector{Pattern: "@p"},
+		"<score:@p:kills>":      &Score{Name: "@p", Objective: "kills"},
+		"<red>a<reset>b":        &Text{Extra: []Component{&Text{Content: "a", S: Style{Color: color.Red}}, &Text{Content: "b"}}},
+		"<red>a</blue>b":        &Text{Content: "ab", S: Style{Color: color.Red}},
+		"<click:open_url:https://example.com>link": &Text{Content: "link", S: Style{
+			ClickEvent: OpenUrl("https://example.com"),
+		}},
+		"<click:run_command:'/say hi'>cmd": &Text{Content: "cmd", S: Style{ClickEvent: RunCommand("/say hi")}},
+		"<insert:'it\\'s'>i":               &Text{Content: "i", S: Style{Insertion: stringPtr("it's")}},
+		"<hover:show_text:'<red>tip'>h": &Text{Content: "h", S: Style{
+			HoverEvent: ShowText(&Text{Content: "tip", S: Style{Color: color.Red}}),
+		}},
+		"<hover:show_item:'minecraft:stone':2>h": &Text{Content: "h", S: Style{
+			HoverEvent: ShowItem(&ShowItemHoverType{Item: key.New(key.MinecraftNamespace, "stone"), Count: 2}),
+		}},
+		"<lang:chat.type.text:Bob:'<red>Hi'>": &Translation{Key: "chat.type.text", With: []Component{
+			&Text{Content: "Bob"},
+			&Text{Content: "Hi", S: Style{Color: color.Red}},
+		}},
+		"<lang_or:a.b:'Fallback %s':x>": &Translation{Key: "a.b", Fallback: "Fallback %s", With: []Component{
+			&Text{Content: "x"},
+		}},
+		"<shadow:#ff000080>s": &Text{Content: "s", S: Style{ShadowColor: argbPtr(0x80ff0000)}},
+		"<shadow:red:1>s":     &Text{Content: "s", S: Style{ShadowColor: argbPtr(0xffff5555)}},
+		"<!shadow>s":          &Text{Content: "s", S: Style{ShadowColor: argbPtr(0)}},
+		// self-closing styling tags style no content
+		"<red/>a<b/>b": &Text{Content: "ab"},
+	} {
+		c, err := m.UnmarshalString(input)
+		require.NoError(t, err, input)
+		require.True(t, Equal(expected, c), "%s: %#v", input, c)
+	}
+}
+
+func TestMiniMessage_Unmarshal_strict(t *testing.T) {
+	strict := &MiniMessage{Strict: true}
+	for input, expected := range map[string]string{
+		"<red>unclosed":             "unclosed tag <red>",
+		"<red><bold>x</red></bold>": "tag </red> closed before tag </bold>",
+		"x</red>":                   "closing tag </red> without opening tag",
+		"<hover:show_text:'<red>'>": "unclosed tag <red>",
+	} {
+		_, err := strict.UnmarshalString(input)
+		require.EqualError(t, err, expected, input)
+		_, err = m.UnmarshalString(input)
+		require.NoError(t, err, input)
+	}
+	_, err := strict.UnmarshalString("<red>a <key:key.jump> <b>b</b></red><red>c<reset>")
+	require.NoError(t, err)
+	_, err = strict.UnmarshalString("<red/>a")
+	require.NoError(t, err)
+}
+
+func TestMiniMessage_Marshal(t *testing.T) {
+	for expected, c := range map[string]Component{
+		"<red>Hello <bold>world</bold>!</red>": &Text{Content: "Hello ", S: Style{Color: color.Red}, Extra: []Component{
+			&Text{Content: "world", S: Style{Bold: True}},
+			&Text{Content: "!"},
+		}},
+		`a \< b \\ c`: &Text{Content: `a < b \ c`},
+		"<#123456><!italic>x</!italic></#123456>":         &Text{Content: "x", S: Style{Color: color.HexInt(0x123456), Italic: False}},
+		"<click:open_url:'https://example.com'>l</click>": &Text{Content: "l", S: Style{ClickEvent: OpenUrl("https://example.com")}},
+		"<hover:show_text:'<red>it\\'s</red>'>h</hover>": &Text{Content: "h", S: Style{
+			HoverEvent: ShowText(&Text{Content: "it's", S: Style{Color: color.Red}}),
+		}},
+		"<font:minecraft:uniform><insert:x>f</insert></font>": &Text{Content: "f", S: Style{Font: UniformFont, Insertion: stringPtr("x")}},
+		"<shadow:#ff000080>s</shadow>":                        &Text{Content: "s", S: Style{ShadowColor: argbPtr(0x80ff0000)}},
+		"<gold><lang_or:a.b:A %s:'<red>x</red>'></gold>": &Translation{Key: "a.b", Fallback: "A %s", S: Style{Color: color.Gold}, With: []Component{
+			&Text{Content: "x", S: Style{Color: color.Red}},
+		}},
+		"<key:key.jump><score:@p:kills><selector:@a:', '>": &Text{Extra: []Component{
+			&Keybind{Key: "key.jump"},
+			&Score{Name: "@p", Objective: "kills"},
+			&Selector{Pattern: "@a", Separator: &Text{Content: ", "}},
+		}},
+	} {
+		s, err := m.MarshalString(c)
+		require.NoError(t, err)
+		require.Equal(t, expected, s)
+	}
+	_, err := m.MarshalString(&NBT{Path: "a"})
+	require.EqualError(t, err, "unsupported component type *component.NBT")
+}
+
+func TestMiniMessage_roundTrip(t *testing.T) {
+	id := uuid.MustParse("f84c6a79-0a4e-45e0-879b-cd49ebd4c4e2")
+	for _, c := range []Component{
+		&Text{Content: "<not a tag> \\", S: Style{Color: color.Aqua, Underlined: True}},
+		&Text{Content: "e", S: Style{HoverEvent: ShowEntity(&ShowEntityHoverType{
+			Type: key.New(key.MinecraftNamespace, "pig"), Id: id, Name: &Text{Content: "Pig: 'Bob'"},
+		})}},
+		&Text{Content: "a", S: Style{ClickEvent: SuggestCommand("/msg a:b 'c'")}, Extra: []Component{
+			&Translation{Key: "k", With: []Component{&Text{Content: "<b>", S: Style{Bold: True}}}},
+		}},
+	} {
+		s, err := m.MarshalString(c)
+		require.NoError(t, err)
+		decoded, err := (&MiniMessage{Strict: true}).UnmarshalString(s)
+		require.NoError(t, err, s)
+		require.True(t, EqualEffective(c, decoded), "%s: %#v", s, decoded)
+	}
+}
+
+func stringPtr(s string) *string { return &s }
+
+func argbPtr(c color.ARGB) *color.ARGB { return &c }
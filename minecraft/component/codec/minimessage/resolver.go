@@ -0,0 +1,135 @@
+package minimessage
+
+import (
+	"strings"
+
+	. "go.minekube.com/common/minecraft/component"
+)
+
+// Tag is a resolved tag.
+type Tag struct {
+	style  func(s *Style) // styling tag applying to the content until it is closed
+	insert Component      // self-closing tag inserting a component
+	reset  bool           // closes all open tags
+}
+
+// Insert returns a self-closing tag inserting a copy of c.
+func Insert(c Component) *Tag {
+	return &Tag{insert: c}
+}
+
+// Unparsed returns a self-closing tag inserting s as text without parsing tags in it.
+func Unparsed(s string) *Tag {
+	return Insert(&Text{Content: s})
+}
+
+// Styling returns a tag applying all set fields of s to the content until the tag is closed.
+func Styling(s Style) *Tag {
+	return styleTag(func(style *Style) { style.Merge(&s, MergeAlways, MergeAll) })
+}
+
+func styleTag(fn func(s *Style)) *Tag { return &Tag{style: fn} }
+
+// TagResolver resolves tags by name.
+type TagResolver interface {
+	// Has reports whether the resolver resolves the lowercase tag name.
+	// It is used to recognize closing tags.
+	Has(name string) bool
+	// Resolve returns the tag with the lowercase name and the arguments.
+	// A nil Tag leaves the tag as text, e.g. if the arguments are invalid.
+	Resolve(name string, args []string, ctx *Context) (*Tag, error)
+}
+
+// Context is the context of a tag resolved while parsing.
+type Context struct {
+	p *parser
+}
+
+// Parse parses s as MiniMessage with the same settings and tag resolvers
+// as the message the tag is part of, e.g. to parse a tag argument.
+func (c *Context) Parse(s string) (Component, error) {
+	return c.p.sub(s)
+}
+
+// Resolver returns a TagResolver resolving tags with the case-insensitive name using fn,
+// e.g. fn may format the arguments of "<balance:'#.00'>".
+func Resolver(name string, fn func(args []string, ctx *Context) (*Tag, error)) TagResolver {
+	return &namedResolver{name: strings.ToLower(name), fn: fn}
+}
+
+// Placeholder returns a TagResolver inserting a copy of c for the self-closing tag with the name.
+func Placeholder(name string, c Component) TagResolver {
+	return Resolver(name, func([]string, *Context) (*Tag, error) {
+		return Insert(c), nil
+	})
+}
+
+// UnparsedPlaceholder returns a TagResolver inserting the text s for the self-closing tag with the name.
+// Tags in s are not parsed, which makes it safe for user input.
+func UnparsedPlaceholder(name, s string) TagResolver {
+	return Resolver(name, func([]string, *Context) (*Tag, error) {
+		return Unparsed(s), nil
+	})
+}
+
+// ParsedPlaceholder returns a TagResolver inserting s parsed as MiniMessage for the self-closing
+// tag with the name. Tags opened in s are closed at its end.
+// Do not use it for user input, use UnparsedPlaceholder instead.
+func ParsedPlaceholder(name, s string) TagResolver {
+	return Resolver(name, func(_ []string, ctx *Context) (*Tag, error) {
+		c, err := ctx.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return Insert(c), nil
+	})
+}
+
+// StylingPlaceholder returns a TagResolver applying the style for the tag with the name until it is closed.
+func StylingPlaceholder(name string, s Style) TagResolver {
+	return Resolver(name, func([]string, *Context) (*Tag, error) {
+		return Styling(s), nil
+	})
+}
+
+// Resolvers returns a TagResolver combining the resolvers.
+// Earlier resolvers take precedence over later ones.
+func Resolvers(resolvers ...TagResolver) TagResolver {
+	return resolverList(resolvers)
+}
+
+type namedResolver struct {
+	name string
+	fn   func(args []string, ctx *Context) (*Tag, error)
+}
+
+func (r *namedResolver) Has(name string) bool {
+	return name == r.name
+}
+
+func (r *namedResolver) Resolve(name string, args []string, ctx *Context) (*Tag, error) {
+	if name != r.name {
+		return nil, nil
+	}
+	return r.fn(args, ctx)
+}
+
+type resolverList []TagResolver
+
+func (l resolverList) Has(name string) bool {
+	for _, r := range l {
+		if r != nil && r.Has(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l resolverList) Resolve(name string, args []string, ctx *Context) (*Tag, error) {
+	for _, r := range l {
+		if r != nil && r.Has(name) {
+			return r.Resolve(name, args, ctx)
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,112 @@
+package minimessage
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+func TestPlaceholders(t *testing.T) {
+	c, err := m.UnmarshalString("<gray><<player>> <message>",
+		Placeholder("player", &Text{Content: "Bob", S: Style{Color: color.Gold}}),
+		UnparsedPlaceholder("message", "<red>hi</red> \\"),
+	)
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Content: "<", S: Style{Color: color.Gray}, Extra: []Component{
+		&Text{Content: "Bob", S: Style{Color: color.Gold}},
+		&Text{Content: "> <red>hi</red> \\"},
+	}}, c), "%#v", c)
+
+	c, err = m.UnmarshalString("<p> <p>", ParsedPlaceholder("p", "<red>red"))
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "red", S: Style{Color: color.Red}},
+		&Text{Content: " "},
+		&Text{Content: "red", S: Style{Color: color.Red}},
+	}}, c), "%#v", c)
+
+	_, err = m.UnmarshalString("<p>", ParsedPlaceholder("p", "<p>"))
+	require.EqualError(t, err, "maximum nesting depth of 16 exceeded")
+}
+
+func TestStylingPlaceholder(t *testing.T) {
+	c, err := (&MiniMessage{Strict: true}).UnmarshalString("<warn>Careful</warn> ok",
+		StylingPlaceholder("warn", Style{Color: color.Red, Bold: True}))
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "Careful", S: Style{Color: color.Red, Bold: True}},
+		&Text{Content: " ok"},
+	}}, c), "%#v", c)
+}
+
+func TestMiniMessage_UnmarshalString_resolvers(t *testing.T) {
+	// the resolvers slice of the caller is not modified
+	resolvers := make([]TagResolver, 1, 2)
+	resolvers[0] = Placeholder("p", &Text{Content: "x"})
+	_, err := m.UnmarshalString("<p>", resolvers...)
+	require.NoError(t, err)
+	require.Nil(t, resolvers[:2][1])
+}
+
+func TestResolver_caseInsensitive(t *testing.T) {
+	c, err := (&MiniMessage{Strict: true}).UnmarshalString("<Warn><player></WARN> <PLAYER>",
+		Placeholder("Player", &Text{Content: "Bob"}),
+		StylingPlaceholder("WARN", Style{Color: color.Red}))
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "Bob", S: Style{Color: color.Red}},
+		&Text{Content: " Bob"},
+	}}, c), "%#v", c)
+}
+
+func TestResolver(t *testing.T) {
+	balance := Resolver("balance", func(args []string, ctx *Context) (*Tag, error) {
+		const value = 1234.5
+		if len(args) == 0 {
+			return Unparsed(strconv.FormatFloat(value, 'f', -1, 64)), nil
+		}
+		precision, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, nil // left as text
+		}
+		return Unparsed(strconv.FormatFloat(value, 'f', precision, 64)), nil
+	})
+	link := Resolver("link", func(args []string, ctx *Context) (*Tag, error) {
+		if len(args) != 2 {
+			return nil, nil
+		}
+		text, err := ctx.Parse(args[1])
+		if err != nil {
+			return nil, err
+		}
+		text.Style().ClickEvent = OpenUrl(args[0])
+		return Insert(text), nil
+	})
+
+	c, err := m.UnmarshalString("<balance> <balance:2> <balance:x> <link:'https://example.com':'<u>here'>",
+		balance, link)
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{Content: "1234.5 1234.50 <balance:x> ", Extra: []Component{
+		&Text{Content: "here", S: Style{Underlined: True, ClickEvent: OpenUrl("https://example.com")}},
+	}}, c), "%#v", c)
+}
+
+func TestMiniMessage_Tags(t *testing.T) {
+	// restricted to colors and decorations, e.g. for user input
+	restricted := &MiniMessage{Tags: Resolvers(ColorTags, DecorationTags)}
+	c, err := restricted.UnmarshalString("<red><b>hi</b> <click:run_command:/op>x</click><reset>",
+		UnparsedPlaceholder("name", "Bob"))
+	require.NoError(t, err)
+	require.True(t, Equal(&Text{S: Style{Color: color.Red}, Extra: []Component{
+		&Text{Content: "hi", S: Style{Bold: True}},
+		&Text{Content: " <click:run_command:/op>x</click><reset>"},
+	}}, c), "%#v", c)
+
+	// placeholders override standard tags
+	c, err = m.UnmarshalString("<red>", UnparsedPlaceholder("red", "blue"))
+	require.NoError(t, err)
+	require.Equal(t, &Text{Content: "blue"}, c)
+}
@@ -0,0 +1,411 @@
+package minimessage
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+// tagFunc returns the tag for the arguments or nil if they are invalid.
+type tagFunc func(ctx *Context, args []string) (*Tag, error)
+
+// Standard tag resolvers, e.g. to restrict the tags of user input with MiniMessage.Tags.
+var (
+	// ColorTags resolves the named color, hex color and color (colour, c) tags.
+	ColorTags TagResolver = standardTags{"color"}
+	// DecorationTags resolves the decoration tags, including the negated ones.
+	DecorationTags TagResolver = standardTags{"decoration"}
+	// ClickTags resolves the click tag.
+	ClickTags TagResolver = standardTags{"click"}
+	// HoverTags resolves the hover tag.
+	HoverTags TagResolver = standardTags{"hover"}
+	// InsertionTags resolves the insert tag.
+	InsertionTags TagResolver = standardTags{"insert"}
+	// FontTags resolves the font tag.
+	FontTags TagResolver = standardTags{"font"}
+	// ShadowTags resolves the shadow and !shadow tags.
+	ShadowTags TagResolver = standardTags{"shadow"}
+	// ResetTags resolves the reset tag.
+	ResetTags TagResolver = standardTags{"reset"}
+	// NewlineTags resolves the newline and br tags.
+	NewlineTags TagResolver = standardTags{"newline"}
+	// TranslationTags resolves the lang (tr, translate) and lang_or (tr_or, translate_or) tags.
+	TranslationTags TagResolver = standardTags{"lang"}
+	// KeybindTags resolves the key tag.
+	KeybindTags TagResolver = standardTags{"key"}
+	// SelectorTags resolves the selector and sel tags.
+	SelectorTags TagResolver = standardTags{"selector"}
+	// ScoreTags resolves the score tag.
+	ScoreTags TagResolver = standardTags{"score"}
+	// StandardTags resolves all standard tags.
+	StandardTags TagResolver = standardTags{
+		"color", "decoration", "click", "hover", "insert", "font", "shadow",
+		"reset", "newline", "lang", "key", "selector", "score",
+	}
+)
+
+// standardTags resolves the standard tags of the groups.
+type standardTags []string
+
+func (s standardTags) lookup(name string) (tagFunc, bool) {
+	_, group, fn, ok := lookup(name)
+	if !ok {
+		return nil, false
+	}
+	for _, g := range s {
+		if g == group {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+func (s standardTags) Has(name string) bool {
+	_, ok := s.lookup(name)
+	return ok
+}
+
+func (s standardTags) Resolve(name string, args []string, ctx *Context) (*Tag, error) {
+	fn, ok := s.lookup(name)
+	if !ok {
+		return nil, nil
+	}
+	return fn(ctx, args)
+}
+
+var (
+	// decorationAliases are the tag names of the decorations.
+	decorationAliases = map[string]Decoration{
+		"bold":          Bold,
+		"b":             Bold,
+		"italic":        Italic,
+		"i":             Italic,
+		"em":            Italic,
+		"underlined":    Underlined,
+		"u":             Underlined,
+		"strikethrough": Strikethrough,
+		"st":            Strikethrough,
+		"obfuscated":    Obfuscated,
+		"obf":           Obfuscated,
+	}
+	// colorAliases are the alternative names of named colors.
+	colorAliases = map[string]*color.Named{
+		"grey":      color.Gray,
+		"dark_grey": color.DarkGray,
+	}
+	// tagAliases are the alternative names of tags.
+	tagAliases = map[string]string{
+		"colour":       "color",
+		"c":            "color",
+		"tr":           "lang",
+		"translate":    "lang",
+		"tr_or":        "lang_or",
+		"translate_or": "lang_or",
+		"sel":          "selector",
+		"br":           "newline",
+	}
+
+	// tags are the tags by name, set in init since tags parse nested MiniMessage.
+	tags map[string]tagFunc
+)
+
+func init() {
+	tags = map[string]tagFunc{
+		"color":    colorTag,
+		"shadow":   shadowTag,
+		"!shadow":  noShadowTag,
+		"click":    clickTag,
+		"hover":    hoverTag,
+		"insert":   insertTag,
+		"font":     fontTag,
+		"reset":    resetTag,
+		"newline":  newlineTag,
+		"lang":     langTag,
+		"lang_or":  langOrTag,
+		"key":      keyTag,
+		"selector": selectorTag,
+		"score":    scoreTag,
+	}
+}
+
+// lookup returns the canonical name, group and function of the standard tag name.
+func lookup(name string) (canonical, group string, fn tagFunc, ok bool) {
+	if alias, ok := tagAliases[name]; ok {
+		name = alias
+	}
+	if fn, ok := tags[name]; ok {
+		switch name {
+		case "!shadow":
+			return name, "shadow", fn, true
+		case "lang_or":
+			return name, "lang", fn, true
+		}
+		return name, name, fn, true
+	}
+	if n, ok := namedColor(name); ok {
+		return n.Name, "color", func(*Context, []string) (*Tag, error) {
+			return styleTag(func(s *Style) { s.Color = n }), nil
+		}, true
+	}
+	if strings.HasPrefix(name, "#") {
+		c, err := color.Hex(name)
+		if err != nil {
+			return "", "", nil, false
+		}
+		return name, "color", func(*Context, []string) (*Tag, error) {
+			return styleTag(func(s *Style) { s.Color = c }), nil
+		}, true
+	}
+	negated := strings.HasPrefix(name, "!")
+	if d, ok := decorationAliases[strings.TrimPrefix(name, "!")]; ok {
+		if negated {
+			return "!" + string(d), "decoration", func(*Context, []string) (*Tag, error) {
+				return styleTag(func(s *Style) { s.SetDecoration(d, False) }), nil
+			}, true
+		}
+		return string(d), "decoration", decorationTag(d), true
+	}
+	return "", "", nil, false
+}
+
+// canonical returns the canonical name of the tag name to match closing tags with.
+func canonical(name string) string {
+	if c, _, _, ok := lookup(name); ok {
+		return c
+	}
+	return name
+}
+
+func namedColor(name string) (*color.Named, bool) {
+	if n, ok := color.Names[name]; ok {
+		return n, true
+	}
+	n, ok := colorAliases[name]
+	return n, ok
+}
+
+// parseColor parses a named or hex color.
+func parseColor(s string) (color.Color, bool) {
+	s = strings.ToLower(s)
+	if n, ok := namedColor(s); ok {
+		return n, true
+	}
+	c, err := color.Hex(s)
+	return c, err == nil
+}
+
+// parseKey parses a key defaulting to the minecraft namespace.
+func parseKey(s string) (key.Key, bool) {
+	if s == "" {
+		return nil, false
+	}
+	if !strings.Contains(s, ":") {
+		return key.New(key.MinecraftNamespace, s), true
+	}
+	k, err := key.Parse(s)
+	return k, err == nil
+}
+
+func decorationTag(d Decoration) tagFunc {
+	return func(_ *Context, args []string) (*Tag, error) {
+		state := True
+		if len(args) != 0 {
+			b, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return nil, nil
+			}
+			state = StateByBool(b)
+		}
+		return styleTag(func(s *Style) { s.SetDecoration(d, state) }), nil
+	}
+}
+
+func colorTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) != 1 {
+		return nil, nil
+	}
+	c, ok := parseColor(args[0])
+	if !ok {
+		return nil, nil
+	}
+	return styleTag(func(s *Style) { s.Color = c }), nil
+}
+
+// defaultShadowAlpha is the alpha of shadow colors without alpha, 25% opacity.
+const defaultShadowAlpha = 0x40
+
+func shadowTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return nil, nil
+	}
+	alpha := uint8(defaultShadowAlpha)
+	s := args[0]
+	if len(s) == 9 && s[0] == '#' {
+		a, err := strconv.ParseUint(s[7:], 16, 8)
+		if err != nil {
+			return nil, nil
+		}
+		alpha, s = uint8(a), s[:7]
+	}
+	c, ok := parseColor(s)
+	if !ok {
+		return nil, nil
+	}
+	if len(args) == 2 {
+		a, err := strconv.ParseFloat(args[1], 64)
+		if err != nil || a < 0 || a > 1 {
+			return nil, nil
+		}
+		alpha = uint8(a*0xff + 0.5)
+	}
+	shadow := color.MakeARGB(c, alpha)
+	return styleTag(func(s *Style) { s.ShadowColor = &shadow }), nil
+}
+
+func noShadowTag(*Context, []string) (*Tag, error) {
+	return styleTag(func(s *Style) {
+		shadow := color.ARGB(0)
+		s.ShadowColor = &shadow
+	}), nil
+}
+
+func clickTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) < 2 {
+		return nil, nil
+	}
+	action, ok := ClickActions[strings.ToLower(args[0])]
+	if !ok {
+		return nil, nil
+	}
+	e := NewClickEvent(action, strings.Join(args[1:], ":"))
+	return styleTag(func(s *Style) { s.ClickEvent = e }), nil
+}
+
+func hoverTag(ctx *Context, args []string) (*Tag, error) {
+	if len(args) < 2 {
+		return nil, nil
+	}
+	var e HoverEvent
+	switch strings.ToLower(args[0]) {
+	case ShowTextAction.Name():
+		text, err := ctx.Parse(strings.Join(args[1:], ":"))
+		if err != nil {
+			return nil, err
+		}
+		e = ShowText(text)
+	case ShowItemAction.Name():
+		item, ok := parseKey(args[1])
+		if !ok || len(args) > 3 {
+			return nil, nil
+		}
+		count := 1
+		if len(args) == 3 {
+			n, err := strconv.Atoi(args[2])
+			if err != nil {
+				return nil, nil
+			}
+			count = n
+		}
+		e = ShowItem(&ShowItemHoverType{Item: item, Count: count})
+	case ShowEntityAction.Name():
+		typ, ok := parseKey(args[1])
+		if !ok || len(args) < 3 || len(args) > 4 {
+			return nil, nil
+		}
+		id, err := uuid.Parse(args[2])
+		if err != nil {
+			return nil, nil
+		}
+		entity := &ShowEntityHoverType{Type: typ, Id: id}
+		if len(args) == 4 {
+			if entity.Name, err = ctx.Parse(args[3]); err != nil {
+				return nil, err
+			}
+		}
+		e = ShowEntity(entity)
+	default:
+		return nil, nil
+	}
+	return styleTag(func(s *Style) { s.HoverEvent = e }), nil
+}
+
+func insertTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	insertion := strings.Join(args, ":")
+	return styleTag(func(s *Style) { s.Insertion = &insertion }), nil
+}
+
+func fontTag(_ *Context, args []string) (*Tag, error) {
+	font, ok := parseKey(strings.Join(args, ":"))
+	if !ok {
+		return nil, nil
+	}
+	return styleTag(func(s *Style) { s.Font = font }), nil
+}
+
+func resetTag(*Context, []string) (*Tag, error) {
+	return &Tag{reset: true}, nil
+}
+
+func newlineTag(*Context, []string) (*Tag, error) {
+	return &Tag{insert: &Text{Content: "\n"}}, nil
+}
+
+func langTag(ctx *Context, args []string) (*Tag, error) {
+	if len(args) == 0 || args[0] == "" {
+		return nil, nil
+	}
+	return translationTag(ctx, &Translation{Key: args[0]}, args[1:])
+}
+
+func langOrTag(ctx *Context, args []string) (*Tag, error) {
+	if len(args) < 2 || args[0] == "" {
+		return nil, nil
+	}
+	return translationTag(ctx, &Translation{Key: args[0], Fallback: args[1]}, args[2:])
+}
+
+func translationTag(ctx *Context, t *Translation, args []string) (*Tag, error) {
+	for _, arg := range args {
+		c, err := ctx.Parse(arg)
+		if err != nil {
+			return nil, err
+		}
+		t.With = append(t.With, c)
+	}
+	return &Tag{insert: t}, nil
+}
+
+func keyTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	return &Tag{insert: &Keybind{Key: strings.Join(args, ":")}}, nil
+}
+
+func selectorTag(ctx *Context, args []string) (*Tag, error) {
+	if len(args) == 0 || len(args) > 2 || args[0] == "" {
+		return nil, nil
+	}
+	s := &Selector{Pattern: args[0]}
+	if len(args) == 2 {
+		var err error
+		if s.Separator, err = ctx.Parse(args[1]); err != nil {
+			return nil, err
+		}
+	}
+	return &Tag{insert: s}, nil
+}
+
+func scoreTag(_ *Context, args []string) (*Tag, error) {
+	if len(args) != 2 {
+		return nil, nil
+	}
+	return &Tag{insert: &Score{Name: args[0], Objective: args[1]}}, nil
+}
@@ -1,7 +1,6 @@
 package codec
 
 import (
-	"fmt"
 	"go.minekube.com/common/minecraft/component"
 	"io"
 	"strings"
@@ -10,37 +9,30 @@ import (
 // Plain is a plain text component serializer.
 // Plain does not support more complex features such as, but not limited
 // to, colours, decorations, ClickEvent and HoverEvent.
-type Plain struct{}
+type Plain struct {
+	// The optional Translator to render Translation components with.
+	// Translations it does not know are rendered using their fallback or key.
+	Translator component.Translator
+}
 
 var _ Codec = (*Plain)(nil)
 
 func (p Plain) Marshal(wr io.Writer, c component.Component) error {
-	b := new(strings.Builder)
-	if err := p.encode(b, c); err != nil {
+	b := new(plainListener)
+	f := &component.Flattener{Translator: p.Translator}
+	if err := f.Flatten(c, b); err != nil {
 		return err
 	}
 	_, err := wr.Write([]byte(b.String()))
 	return err
 }
 
-func (p Plain) encode(b *strings.Builder, c component.Component) (err error) {
-	switch t := c.(type) {
-	case *component.Text:
-		_, err = b.WriteString(t.Content)
-	default:
-		err = fmt.Errorf("unsupported component type %T", c)
-	}
-	if err != nil {
-		return err
-	}
+// plainListener writes the flattened text ignoring all styles.
+type plainListener struct{ strings.Builder }
 
-	for _, child := range c.Children() {
-		if err = p.encode(b, child); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+func (*plainListener) PushStyle(*component.Style) {}
+func (b *plainListener) Text(text string)         { _, _ = b.WriteString(text) }
+func (*plainListener) PopStyle(*component.Style)  {}
 
 func (Plain) Unmarshal(str []byte) (component.Component, error) {
 	return &component.Text{Content: string(str)}, nil
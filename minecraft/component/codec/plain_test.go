@@ -23,3 +23,89 @@ func TestPlain_Unmarshal(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, tx, &component.Text{Content: "Hello there!"})
 }
+
+func TestPlain_Marshal_selector(t *testing.T) {
+	b := new(strings.Builder)
+	err := p.Marshal(b, &component.Text{
+		Content: "Near: ",
+		Extra:   []component.Component{&component.Selector{Pattern: "@a[distance=..5]"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Near: @a[distance=..5]", b.String())
+}
+
+func TestPlain_Marshal_keybind(t *testing.T) {
+	b := new(strings.Builder)
+	err := p.Marshal(b, &component.Text{
+		Content: "Press ",
+		Extra: []component.Component{
+			&component.Keybind{Key: "key.jump"},
+			&component.Text{Content: " or "},
+			&component.Keybind{Key: "key.custom.unknown"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Press Space or key.custom.unknown", b.String())
+}
+
+func TestPlain_Marshal_translation(t *testing.T) {
+	tr := &component.Translation{
+		Key:      "lobby.welcome",
+		Fallback: "Welcome, %s! You have %2$s%% of %1$s.",
+		With: []component.Component{
+			&component.Text{Content: "Steve"},
+			&component.Text{Content: "50"},
+		},
+	}
+
+	b := new(strings.Builder)
+	require.NoError(t, p.Marshal(b, tr))
+	require.Equal(t, "Welcome, Steve! You have 50% of Steve.", b.String())
+
+	translated := &Plain{Translator: component.TranslatorFunc(func(key string) (string, bool) {
+		if key == "lobby.welcome" {
+			return "Hi %s", true
+		}
+		return "", false
+	})}
+	b.Reset()
+	require.NoError(t, translated.Marshal(b, tr))
+	require.Equal(t, "Hi Steve", b.String())
+
+	b.Reset()
+	require.NoError(t, p.Marshal(b, &component.Translation{Key: "unknown.key", With: tr.With}))
+	require.Equal(t, "unknown.key", b.String())
+
+	// argument indexes start at 1
+	b.Reset()
+	require.NoError(t, p.Marshal(b, &component.Translation{Key: "a", Fallback: "%0$s %s %3$s", With: tr.With}))
+	require.Equal(t, "%0$s Steve %3$s", b.String())
+}
+
+func TestPlain_Marshal_primitive(t *testing.T) {
+	b := new(strings.Builder)
+	err := p.Marshal(b, &component.Translation{
+		Key:      "stats.kills",
+		Fallback: "%s kills (%s%%)",
+		With: []component.Component{
+			&component.Primitive{Value: int64(12)},
+			&component.Primitive{Value: 37.5},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "12 kills (37.5%)", b.String())
+}
+
+func TestPlain_Marshal_score(t *testing.T) {
+	b := new(strings.Builder)
+	err := p.Marshal(b, &component.Text{
+		Content: "Kills: ",
+		Extra: []component.Component{
+			&component.Score{Name: "@p", Objective: "kills", Value: "12"},
+			&component.NBT{Path: "Health", Source: &component.EntityNBTSource{Selector: "@s"},
+				Extra: []component.Component{&component.Text{Content: "!"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Kills: 12!", b.String())
+}
@@ -0,0 +1,123 @@
+package component
+
+// Compact returns an optimized copy of the component tree rooted at c that renders the same.
+// The tree rooted at c is not modified.
+//
+// Compacting removes empty text components, strips style fields that are already inherited
+// from the parent, merges adjacent text siblings with equal style into one component and
+// hoists the single child of a text component without content into its place.
+// Show text hover events are compacted as well. Translation arguments are compacted
+// but never removed nor merged, since their positions are significant.
+func Compact(c Component) Component {
+	if c == nil {
+		return nil
+	}
+	return compact(Clone(c), nil)
+}
+
+func compact(c Component, parent *Style) Component {
+	s := c.Style()
+	stripInherited(s, parent)
+	s.HoverEvent = mapShowText(s.HoverEvent, func(text Component) Component {
+		return compact(text, nil)
+	})
+	effective := *s
+	effective.Inherit(parent)
+
+	if t, ok := c.(*Translation); ok {
+		for i, arg := range t.With {
+			if arg != nil {
+				t.With[i] = compact(arg, &effective)
+			}
+		}
+		return t
+	}
+
+	var children []Component
+	for _, child := range c.Children() {
+		if child == nil {
+			continue
+		}
+		child = compact(child, &effective)
+		if isEmptyText(child) {
+			continue
+		}
+		if n := len(children); n != 0 && mergeTexts(children[n-1], child) {
+			continue
+		}
+		children = append(children, child)
+	}
+
+	t, ok := c.(*Text)
+	if ok && len(children) != 0 {
+		// merge the first child into the content if it has no style of its own
+		if first, ok := children[0].(*Text); ok && len(first.Extra) == 0 && first.S.IsZero() {
+			t.Content += first.Content
+			children = children[1:]
+		}
+	}
+	if len(children) == 0 {
+		children = nil
+	}
+	c.SetChildren(children)
+
+	if ok && t.Content == "" && len(children) == 1 {
+		// hoist the single child, which takes over the style of its parent
+		child := children[0]
+		childStyle := child.Style()
+		childStyle.Inherit(&t.S)
+		stripInherited(childStyle, parent)
+		return child
+	}
+	return c
+}
+
+func isEmptyText(c Component) bool {
+	t, ok := c.(*Text)
+	return ok && t.Content == "" && len(t.Extra) == 0
+}
+
+// mergeTexts appends next to prev if both are texts that can be merged, and reports whether it did.
+func mergeTexts(prev, next Component) bool {
+	p, ok := prev.(*Text)
+	if !ok || len(p.Extra) != 0 {
+		return false
+	}
+	n, ok := next.(*Text)
+	if !ok || !p.S.Equal(&n.S) {
+		return false
+	}
+	p.Content += n.Content
+	p.Extra = n.Extra
+	return true
+}
+
+// stripInherited unsets all fields of s that are equal to the fields inherited from parent.
+func stripInherited(s, parent *Style) {
+	if parent == nil || s.IsZero() {
+		return
+	}
+	for _, d := range DecorationsOrder {
+		if state := s.Decoration(d); state != NotSet && state == parent.Decoration(d) {
+			s.SetDecoration(d, NotSet)
+		}
+	}
+	if s.Font != nil && equalKey(s.Font, parent.Font) {
+		s.Font = nil
+	}
+	if s.Color != nil && equalColor(s.Color, parent.Color) {
+		s.Color = nil
+	}
+	if s.ShadowColor != nil && parent.ShadowColor != nil && *s.ShadowColor == *parent.ShadowColor {
+		s.ShadowColor = nil
+	}
+	if s.Insertion != nil && parent.Insertion != nil && *s.Insertion == *parent.Insertion {
+		s.Insertion = nil
+	}
+	if s.ClickEvent != nil && equalClickEvent(s.ClickEvent, parent.ClickEvent) {
+		s.ClickEvent = nil
+	}
+	if s.HoverEvent != nil && equalHoverEvent(s.HoverEvent, parent.HoverEvent, false) {
+		s.HoverEvent = nil
+	}
+}
@@ -0,0 +1,98 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+)
+
+func TestCompact(t *testing.T) {
+	tree := &Text{
+		S: Style{Color: color.Gold},
+		Extra: []Component{
+			&Text{Content: "Hello", S: Style{Color: color.Gold}},
+			&Text{},
+			&Text{Content: " ", S: Style{}},
+			&Text{Content: "world", S: Style{Bold: True}},
+			&Text{Content: "!", S: Style{Bold: True, Color: color.Gold}},
+			&Translation{Key: "a", With: []Component{
+				&Text{Content: "arg", S: Style{Color: color.Gold}},
+				&Text{},
+			}},
+		},
+	}
+	original := Clone(tree)
+
+	compacted := Compact(tree)
+	require.True(t, Equal(original, tree), "original tree must not be modified")
+	require.Equal(t, &Text{
+		Content: "Hello ",
+		S:       Style{Color: color.Gold},
+		Extra: []Component{
+			&Text{Content: "world!", S: Style{Bold: True}},
+			&Translation{Key: "a", With: []Component{
+				&Text{Content: "arg"},
+				&Text{},
+			}},
+		},
+	}, compacted)
+}
+
+func TestCompact_hoist(t *testing.T) {
+	tree := &Text{
+		S: Style{Color: color.Red},
+		Extra: []Component{
+			&Text{Extra: []Component{&Keybind{Key: "key.jump", S: Style{Italic: True}}}},
+		},
+	}
+	require.Equal(t, &Keybind{Key: "key.jump", S: Style{Color: color.Red, Italic: True}}, Compact(tree))
+	require.Equal(t, &Text{}, Compact(&Text{Extra: []Component{&Text{}}}))
+	require.Equal(t, &Primitive{Value: int64(3), S: Style{Bold: True}},
+		Compact(&Text{S: Style{Bold: True}, Extra: []Component{&Primitive{Value: int64(3)}}}))
+}
+
+// customHover is a show_text HoverEvent implementation of another package.
+type customHover struct{ text Component }
+
+func (h *customHover) Action() HoverAction { return ShowTextAction }
+func (h *customHover) Value() interface{}  { return h.text }
+
+func TestCompact_hover(t *testing.T) {
+	c := Compact(&Text{Content: "a", S: Style{HoverEvent: ShowText(&Text{Extra: []Component{&Text{Content: "b"}}})}})
+	require.Equal(t, &Text{Content: "b"}, c.Style().HoverEvent.Value())
+
+	custom := &customHover{text: &Text{Extra: []Component{&Text{Content: "b"}}}}
+	c = Compact(&Text{Content: "a", S: Style{HoverEvent: custom}})
+	require.Same(t, custom, c.Style().HoverEvent, "other hover event implementations are kept")
+}
+
+func TestCompact_legacy(t *testing.T) {
+	l := &legacy.Legacy{}
+	for _, input := range []string{
+		"§b§lHello §b§lthere§r!",
+		"§6§lGold §c§lred§6§l gold §rreset",
+		"§aA§aB§aC",
+	} {
+		c, err := l.Unmarshal([]byte(input))
+		require.NoError(t, err)
+		compacted := Compact(c)
+
+		// renders the same
+		before, after := new(strings.Builder), new(strings.Builder)
+		require.NoError(t, l.Marshal(before, c))
+		require.NoError(t, l.Marshal(after, compacted))
+		require.Equal(t, before.String(), after.String())
+
+		// but is smaller
+		before.Reset()
+		after.Reset()
+		require.NoError(t, codec.JsonModern.Marshal(before, c))
+		require.NoError(t, codec.JsonModern.Marshal(after, compacted))
+		require.Less(t, after.Len(), before.Len(), "%s\n%s", before, after)
+	}
+}
@@ -4,6 +4,8 @@ package component
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 )
 
 type Component interface {
@@ -19,9 +21,58 @@ type Text struct {
 }
 
 type Translation struct {
-	Key  string // Translation key
-	S    Style
-	With []Component
+	Key      string // Translation key
+	Fallback string // The optional format shown if the key is not known, empty for none.
+	S        Style
+	With     []Component
+}
+
+// Primitive is a raw number or boolean argument of a Translation.
+// It has no children. A Primitive with a style is encoded as a text component.
+type Primitive struct {
+	Value interface{} // One of bool, int64 or float64.
+	S     Style
+}
+
+// Score is a component displaying the score of an entity on a scoreboard objective.
+type Score struct {
+	Name      string // The score holder, either a name or an entity selector (e.g. "@s").
+	Objective string // The scoreboard objective name.
+	Value     string // The optional pre-resolved value, empty if not resolved.
+	S         Style
+	Extra     []Component
+}
+
+// Selector is a component displaying the names of the entities matched by an entity selector.
+type Selector struct {
+	Pattern   string    // The entity selector pattern (e.g. "@a[distance=..5]").
+	Separator Component // The optional separator between matched entity names, nil for the default.
+	S         Style
+	Extra     []Component
+}
+
+// NBT is a component displaying NBT data read from a block, an entity or a command storage.
+type NBT struct {
+	Path      string    // The NBT path to the displayed data (e.g. "Inventory[0]").
+	Interpret bool      // Whether to interpret the NBT data as a text component.
+	Separator Component // The optional separator between multiple matched values, nil for the default.
+	Source    NBTSource // The source to read the NBT data from.
+	S         Style
+	Extra     []Component
+}
+
+// Object is a component displaying an inline object, such as an atlas sprite or a player head.
+type Object struct {
+	Contents ObjectContents // The displayed object.
+	S        Style
+	Extra    []Component
+}
+
+// Keybind is a component displaying the key currently bound to a client keybind.
+type Keybind struct {
+	Key   string // Keybind identifier (e.g. "key.jump")
+	S     Style
+	Extra []Component
 }
 
 func (t *Text) Children() []Component {
@@ -44,14 +95,104 @@ func (t *Translation) SetChildren(children []Component) {
 	t.With = children
 }
 
+func (p *Primitive) Children() []Component {
+	return nil
+}
+func (p *Primitive) Style() *Style {
+	return &p.S
+}
+func (p *Primitive) SetChildren([]Component) {}
+
+// String returns the text representation of the primitive value.
+func (p *Primitive) String() string {
+	switch v := p.Value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (s *Score) Children() []Component {
+	return s.Extra
+}
+func (s *Score) Style() *Style {
+	return &s.S
+}
+func (s *Score) SetChildren(children []Component) {
+	s.Extra = children
+}
+
+func (s *Selector) Children() []Component {
+	return s.Extra
+}
+func (s *Selector) Style() *Style {
+	return &s.S
+}
+func (s *Selector) SetChildren(children []Component) {
+	s.Extra = children
+}
+
+func (n *NBT) Children() []Component {
+	return n.Extra
+}
+func (n *NBT) Style() *Style {
+	return &n.S
+}
+func (n *NBT) SetChildren(children []Component) {
+	n.Extra = children
+}
+
+func (o *Object) Children() []Component {
+	return o.Extra
+}
+func (o *Object) Style() *Style {
+	return &o.S
+}
+func (o *Object) SetChildren(children []Component) {
+	o.Extra = children
+}
+
+func (k *Keybind) Children() []Component {
+	return k.Extra
+}
+func (k *Keybind) Style() *Style {
+	return &k.S
+}
+func (k *Keybind) SetChildren(children []Component) {
+	k.Extra = children
+}
+
 var (
 	_ json.Marshaler   = (*Text)(nil)
 	_ json.Unmarshaler = (*Text)(nil)
 	_ json.Marshaler   = (*Translation)(nil)
 	_ json.Unmarshaler = (*Translation)(nil)
+	_ json.Marshaler   = (*Score)(nil)
+	_ json.Unmarshaler = (*Score)(nil)
+	_ json.Marshaler   = (*Selector)(nil)
+	_ json.Unmarshaler = (*Selector)(nil)
+	_ json.Marshaler   = (*NBT)(nil)
+	_ json.Unmarshaler = (*NBT)(nil)
+	_ json.Marshaler   = (*Object)(nil)
+	_ json.Unmarshaler = (*Object)(nil)
+	_ json.Marshaler   = (*Keybind)(nil)
+	_ json.Unmarshaler = (*Keybind)(nil)
 )
 
 func (t *Text) MarshalJSON() ([]byte, error)        { panic("use codec.Json instead") }
 func (t *Text) UnmarshalJSON(b []byte) error        { panic("use codec.Json instead") }
 func (t *Translation) UnmarshalJSON(b []byte) error { panic("use codec.Json instead") }
 func (t *Translation) MarshalJSON() ([]byte, error) { panic("use codec.Json instead") }
+func (s *Score) MarshalJSON() ([]byte, error)       { panic("use codec.Json instead") }
+func (s *Score) UnmarshalJSON(b []byte) error       { panic("use codec.Json instead") }
+func (s *Selector) MarshalJSON() ([]byte, error)    { panic("use codec.Json instead") }
+func (s *Selector) UnmarshalJSON(b []byte) error    { panic("use codec.Json instead") }
+func (n *NBT) MarshalJSON() ([]byte, error)         { panic("use codec.Json instead") }
+func (n *NBT) UnmarshalJSON(b []byte) error         { panic("use codec.Json instead") }
+func (o *Object) MarshalJSON() ([]byte, error)      { panic("use codec.Json instead") }
+func (o *Object) UnmarshalJSON(b []byte) error      { panic("use codec.Json instead") }
+func (k *Keybind) MarshalJSON() ([]byte, error)     { panic("use codec.Json instead") }
+func (k *Keybind) UnmarshalJSON(b []byte) error     { panic("use codec.Json instead") }
@@ -0,0 +1,199 @@
+package component
+
+import (
+	"math"
+
+	"go.minekube.com/common/minecraft/color"
+)
+
+// ColorEffect spreads colors across the visible characters of component trees,
+// keeping all other style fields such as decorations and events.
+//
+// Every character of a Text is colored separately. Other components, such as
+// a Translation or Keybind, are colored as a single character. The children of
+// a Translation are its arguments and inherit its color.
+type ColorEffect struct {
+	// Phase shifts the colors by a fraction of the text length, from -1 to 1.
+	// A phase of 1 or -1 reverses a gradient.
+	Phase float64
+
+	// Since Minecraft 1.16+ there can be hex colors (e.g. "#ff5555" instead of the named color "red").
+	// This setting decides whether to use the nearest legacy named color of the hex colors instead,
+	// merging adjacent characters with the same color.
+	//
+	// This setting is false by default to support older client versions.
+	NoDownsampleColor bool
+}
+
+// Gradient returns a copy of c with a gradient through the colors spread across its characters.
+// The tree rooted at c is not modified.
+func (e ColorEffect) Gradient(c Component, colors ...color.Color) Component {
+	stops := rgbs(colors)
+	if c == nil || len(stops) == 0 {
+		return Clone(c)
+	}
+	return e.paint(c, func(i, n int) color.Color {
+		var t float64
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		return gradientAt(stops, mirror(t+e.Phase))
+	})
+}
+
+// Rainbow returns a copy of c with the colors of the rainbow spread across its characters.
+// The tree rooted at c is not modified.
+func (e ColorEffect) Rainbow(c Component) Component {
+	if c == nil {
+		return nil
+	}
+	return e.paint(c, func(i, n int) color.Color {
+		hue := float64(i)/float64(n) + e.Phase
+		hue -= math.Floor(hue)
+		return color.Hsv(hue*360, 1, 1)
+	})
+}
+
+// Transition returns a copy of c colored with the single color at the position Phase
+// in the transition through the colors, where negative phases count from the end.
+// The tree rooted at c is not modified.
+func (e ColorEffect) Transition(c Component, colors ...color.Color) Component {
+	stops := rgbs(colors)
+	if c == nil || len(stops) == 0 {
+		return Clone(c)
+	}
+	t := e.Phase
+	if t < 0 {
+		t++
+	}
+	col := e.downsample(gradientAt(stops, math.Max(0, math.Min(1, t))))
+	c = Clone(c)
+	c.Style().Color = col
+	return c
+}
+
+func rgbs(colors []color.Color) []*color.RGB {
+	stops := make([]*color.RGB, 0, len(colors))
+	for _, c := range colors {
+		if c == nil {
+			continue
+		}
+		rgb, _ := color.Make(c)
+		stops = append(stops, rgb)
+	}
+	return stops
+}
+
+// mirror maps t into [0,1], reflecting it at the ends.
+func mirror(t float64) float64 {
+	t = math.Mod(math.Abs(t), 2)
+	if t > 1 {
+		return 2 - t
+	}
+	return t
+}
+
+// gradientAt returns the color at t in [0,1] of the gradient through the stops.
+func gradientAt(stops []*color.RGB, t float64) *color.RGB {
+	if len(stops) == 1 {
+		return stops[0]
+	}
+	pos := t * float64(len(stops)-1)
+	i := int(pos)
+	if i >= len(stops)-1 {
+		return stops[len(stops)-1]
+	}
+	return stops[i].Blend(stops[i+1], pos-float64(i))
+}
+
+func (e ColorEffect) downsample(c color.Color) color.Color {
+	if e.NoDownsampleColor {
+		return c
+	}
+	return c.Named()
+}
+
+// painter colors the characters of a component tree.
+type painter struct {
+	e        ColorEffect
+	colorAt  func(i, n int) color.Color
+	i, count int
+}
+
+func (e ColorEffect) paint(c Component, colorAt func(i, n int) color.Color) Component {
+	c = Clone(c)
+	p := &painter{e: e, colorAt: colorAt, count: countCharacters(c)}
+	if p.count == 0 {
+		return c
+	}
+	return p.paint(c)
+}
+
+// countCharacters returns the number of characters colored by a painter.
+func countCharacters(c Component) (n int) {
+	switch t := c.(type) {
+	case *Text:
+		for range t.Content {
+			n++
+		}
+	case *Translation, *Primitive:
+		return 1
+	default:
+		n++
+	}
+	for _, child := range c.Children() {
+		if child != nil {
+			n += countCharacters(child)
+		}
+	}
+	return n
+}
+
+func (p *painter) next() color.Color {
+	c := p.e.downsample(p.colorAt(p.i, p.count))
+	p.i++
+	return c
+}
+
+func (p *painter) paint(c Component) Component {
+	switch t := c.(type) {
+	case *Text:
+		if t.Content != "" {
+			var pieces []Component
+			var last *Text
+			for _, r := range t.Content {
+				col := p.next()
+				if last != nil && equalColor(last.S.Color, col) {
+					last.Content += string(r)
+					continue
+				}
+				last = &Text{Content: string(r), S: Style{Color: col}}
+				pieces = append(pieces, last)
+			}
+			if len(pieces) == 1 && len(t.Extra) == 0 {
+				t.S.Color = last.S.Color
+				return t
+			}
+			t.Content = ""
+			t.Extra = append(pieces, t.Extra...)
+			for i := len(pieces); i < len(t.Extra); i++ {
+				if t.Extra[i] != nil {
+					t.Extra[i] = p.paint(t.Extra[i])
+				}
+			}
+			return t
+		}
+	case *Translation, *Primitive:
+		c.Style().Color = p.next()
+		return c
+	default:
+		c.Style().Color = p.next()
+	}
+	children := c.Children()
+	for i, child := range children {
+		if child != nil {
+			children[i] = p.paint(child)
+		}
+	}
+	return c
+}
@@ -0,0 +1,92 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+)
+
+// colorsOf returns the hex colors of the visible characters of c.
+func colorsOf(t *testing.T, c Component) []string {
+	runs, err := (&Flattener{}).Runs(c)
+	require.NoError(t, err)
+	var colors []string
+	for _, run := range runs {
+		for range run.Text {
+			colors = append(colors, run.Style.Color.Hex())
+		}
+	}
+	return colors
+}
+
+func TestColorEffect_Gradient(t *testing.T) {
+	red, blue := color.HexInt(0xff0000), color.HexInt(0x0000ff)
+	click := RunCommand("/spawn")
+	c := &Text{
+		Content: "ab",
+		S:       Style{Bold: True, ClickEvent: click},
+		Extra:   []Component{&Text{Content: "c", S: Style{Color: color.Green, Italic: True}}},
+	}
+	e := ColorEffect{NoDownsampleColor: true}
+	g := e.Gradient(c, red, blue)
+	require.Equal(t, []string{"#ff0000", "#800080", "#0000ff"}, colorsOf(t, g))
+	require.Equal(t, color.Green, c.Extra[0].Style().Color, "original tree must not be modified")
+
+	// decorations and events are kept
+	runs, err := (&Flattener{}).Runs(g)
+	require.NoError(t, err)
+	for _, run := range runs {
+		require.Equal(t, True, run.Style.Bold)
+		require.Equal(t, click, run.Style.ClickEvent)
+	}
+	require.Equal(t, True, runs[len(runs)-1].Style.Italic)
+
+	// phase
+	e.Phase = 1
+	require.Equal(t, []string{"#0000ff", "#800080", "#ff0000"}, colorsOf(t, e.Gradient(c, red, blue)))
+	e.Phase = 0.5
+	require.Equal(t, []string{"#800080", "#0000ff", "#800080"}, colorsOf(t, e.Gradient(c, red, blue)))
+
+	// multiple stops
+	e.Phase = 0
+	require.Equal(t, []string{"#ff0000", "#ffffff", "#0000ff"},
+		colorsOf(t, e.Gradient(&Text{Content: "abc"}, red, color.HexInt(0xffffff), blue)))
+}
+
+func TestColorEffect_Rainbow(t *testing.T) {
+	e := ColorEffect{NoDownsampleColor: true}
+	require.Equal(t, []string{"#ff0000", "#00ff00", "#0000ff"}, colorsOf(t, e.Rainbow(&Text{Content: "abc"})))
+	e.Phase = 1.0 / 3
+	require.Equal(t, []string{"#00ff00", "#0000ff", "#ff0000"}, colorsOf(t, e.Rainbow(&Text{Content: "abc"})))
+
+	// other components are colored as a single character
+	e.Phase = 0
+	c := e.Rainbow(&Text{Content: "a", Extra: []Component{&Keybind{Key: "key.jump"}, &Text{Content: "b"}}})
+	require.Equal(t, []string{"#ff0000", "#00ff00", "#00ff00", "#00ff00", "#00ff00", "#00ff00", "#0000ff"}, colorsOf(t, c))
+}
+
+func TestColorEffect_Transition(t *testing.T) {
+	red, blue := color.HexInt(0xff0000), color.HexInt(0x0000ff)
+	e := ColorEffect{NoDownsampleColor: true, Phase: 0.5}
+	require.Equal(t, []string{"#800080", "#800080"}, colorsOf(t, e.Transition(&Text{Content: "ab"}, red, blue)))
+	e.Phase = -0.25
+	require.Equal(t, []string{"#4000bf"}, colorsOf(t, e.Transition(&Text{Content: "a"}, red, blue)))
+}
+
+func TestColorEffect_downsample(t *testing.T) {
+	c := ColorEffect{}.Gradient(&Text{Content: "aaaabbbb"}, color.Red, color.Blue)
+	for _, child := range c.Children() {
+		_, ok := child.Style().Color.(*color.Named)
+		require.True(t, ok)
+	}
+	// adjacent characters with the same named color are merged
+	require.Less(t, len(c.Children()), 8)
+
+	b := new(strings.Builder)
+	require.NoError(t, (&legacy.Legacy{}).Marshal(b, c))
+	require.Equal(t, "§caaa§5ab§9bbb", b.String())
+}
@@ -0,0 +1,334 @@
+package component
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/key"
+)
+
+// Equal reports whether the component trees a and b are structurally equal,
+// comparing the content, literal style and children of each component.
+//
+// Colors are equal if they have the same RGB value, keys if they have the same string.
+// Components and hover event values of unknown types are compared using reflect.DeepEqual.
+func Equal(a, b Component) bool {
+	return equal(a, b, nil, nil, false)
+}
+
+// EqualEffective is like Equal but compares the effective style of each component,
+// that is its style inheriting from all its ancestors, instead of its literal style.
+// A child repeating a style field that is already set by its parent is thus
+// equal to a child leaving that field unset.
+func EqualEffective(a, b Component) bool {
+	return equal(a, b, &Style{}, &Style{}, true)
+}
+
+func equal(a, b Component, parentA, parentB *Style, effective bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if !equalContent(a, b, effective) {
+		return false
+	}
+	styleA, styleB := a.Style(), b.Style()
+	if effective {
+		sa, sb := *styleA, *styleB
+		sa.Inherit(parentA)
+		sb.Inherit(parentB)
+		styleA, styleB = &sa, &sb
+	}
+	if !styleA.equal(styleB, effective) {
+		return false
+	}
+	childrenA, childrenB := a.Children(), b.Children()
+	if len(childrenA) != len(childrenB) {
+		return false
+	}
+	for i := range childrenA {
+		if !equal(childrenA[i], childrenB[i], styleA, styleB, effective) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalContent compares everything but the style and children of a and b.
+func equalContent(a, b Component, effective bool) bool {
+	switch ta := a.(type) {
+	case *Text:
+		tb, ok := b.(*Text)
+		return ok && ta.Content == tb.Content
+	case *Translation:
+		tb, ok := b.(*Translation)
+		return ok && ta.Key == tb.Key && ta.Fallback == tb.Fallback
+	case *Primitive:
+		tb, ok := b.(*Primitive)
+		return ok && reflect.DeepEqual(ta.Value, tb.Value)
+	case *Score:
+		tb, ok := b.(*Score)
+		return ok && ta.Name == tb.Name && ta.Objective == tb.Objective && ta.Value == tb.Value
+	case *Selector:
+		tb, ok := b.(*Selector)
+		return ok && ta.Pattern == tb.Pattern && equalRoot(ta.Separator, tb.Separator, effective)
+	case *NBT:
+		tb, ok := b.(*NBT)
+		return ok && ta.Path == tb.Path && ta.Interpret == tb.Interpret &&
+			equalNBTSource(ta.Source, tb.Source) && equalRoot(ta.Separator, tb.Separator, effective)
+	case *Object:
+		tb, ok := b.(*Object)
+		return ok && equalObjectContents(ta.Contents, tb.Contents)
+	case *Keybind:
+		tb, ok := b.(*Keybind)
+		return ok && ta.Key == tb.Key
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// equalRoot compares components that do not inherit any style, such as separators and hover texts.
+func equalRoot(a, b Component, effective bool) bool {
+	if effective {
+		return EqualEffective(a, b)
+	}
+	return Equal(a, b)
+}
+
+func equalNBTSource(a, b NBTSource) bool {
+	switch ta := a.(type) {
+	case *StorageNBTSource:
+		tb, ok := b.(*StorageNBTSource)
+		return ok && equalKey(ta.Storage, tb.Storage)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func equalObjectContents(a, b ObjectContents) bool {
+	switch ta := a.(type) {
+	case *AtlasSprite:
+		tb, ok := b.(*AtlasSprite)
+		return ok && equalKey(ta.Atlas, tb.Atlas) && equalKey(ta.Sprite, tb.Sprite)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// Equal reports whether the styles are equal.
+// Colors are equal if they have the same RGB value, keys if they have the same string.
+func (s *Style) Equal(o *Style) bool {
+	return s.equal(o, false)
+}
+
+func (s *Style) equal(o *Style, effective bool) bool {
+	if s.IsZero() || o.IsZero() {
+		return s.IsZero() && o.IsZero()
+	}
+	for _, d := range DecorationsOrder {
+		if s.Decoration(d) != o.Decoration(d) {
+			return false
+		}
+	}
+	return equalKey(s.Font, o.Font) &&
+		equalColor(s.Color, o.Color) &&
+		((s.ShadowColor == nil && o.ShadowColor == nil) ||
+			(s.ShadowColor != nil && o.ShadowColor != nil && *s.ShadowColor == *o.ShadowColor)) &&
+		((s.Insertion == nil && o.Insertion == nil) ||
+			(s.Insertion != nil && o.Insertion != nil && *s.Insertion == *o.Insertion)) &&
+		equalClickEvent(s.ClickEvent, o.ClickEvent) &&
+		equalHoverEvent(s.HoverEvent, o.HoverEvent, effective)
+}
+
+func equalKey(a, b key.Key) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.String() == b.String()
+}
+
+func equalColor(a, b color.Color) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Hex() == b.Hex()
+}
+
+func equalClickEvent(a, b ClickEvent) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Action().Name() == b.Action().Name() && a.Value() == b.Value()
+}
+
+func equalHoverEvent(a, b HoverEvent, effective bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if a.Action().Name() != b.Action().Name() {
+		return false
+	}
+	switch va := a.Value().(type) {
+	case Component:
+		vb, ok := b.Value().(Component)
+		return ok && equalRoot(va, vb, effective)
+	case *ShowItemHoverType:
+		vb, ok := b.Value().(*ShowItemHoverType)
+		return ok && equalKey(va.Item, vb.Item) && va.Count == vb.Count &&
+			((va.NBT == nil && vb.NBT == nil) ||
+				(va.NBT != nil && vb.NBT != nil && va.NBT.String() == vb.NBT.String()))
+	case *ShowEntityHoverType:
+		vb, ok := b.Value().(*ShowEntityHoverType)
+		return ok && equalKey(va.Type, vb.Type) && va.Id == vb.Id && equalRoot(va.Name, vb.Name, effective)
+	default:
+		return reflect.DeepEqual(a.Value(), b.Value())
+	}
+}
+
+// Hash returns a stable hash of the component tree rooted at c.
+// Components that are Equal have the same hash, so it can be used to key maps,
+// e.g. to deduplicate messages before encoding them.
+func Hash(c Component) uint64 {
+	h := &hasher{Hash64: fnv.New64a()}
+	h.component(c)
+	return h.Sum64()
+}
+
+type hasher struct {
+	hash.Hash64
+	buf [8]byte
+}
+
+func (h *hasher) uint64(v uint64) {
+	binary.LittleEndian.PutUint64(h.buf[:], v)
+	_, _ = h.Write(h.buf[:])
+}
+
+func (h *hasher) bool(b bool) {
+	if b {
+		h.uint64(1)
+	} else {
+		h.uint64(0)
+	}
+}
+
+func (h *hasher) string(s string) {
+	h.uint64(uint64(len(s)))
+	_, _ = h.Write([]byte(s))
+}
+
+func (h *hasher) key(k key.Key) {
+	h.bool(k != nil)
+	if k != nil {
+		h.string(k.String())
+	}
+}
+
+func (h *hasher) component(c Component) {
+	h.bool(c != nil)
+	if c == nil {
+		return
+	}
+	h.string(fmt.Sprintf("%T", c))
+	switch t := c.(type) {
+	case *Text:
+		h.string(t.Content)
+	case *Translation:
+		h.string(t.Key)
+		h.string(t.Fallback)
+	case *Primitive:
+		h.string(fmt.Sprintf("%T", t.Value))
+		switch v := t.Value.(type) {
+		case float64:
+			h.uint64(math.Float64bits(v))
+		default:
+			h.string(fmt.Sprint(v))
+		}
+	case *Score:
+		h.string(t.Name)
+		h.string(t.Objective)
+		h.string(t.Value)
+	case *Selector:
+		h.string(t.Pattern)
+		h.component(t.Separator)
+	case *NBT:
+		h.string(t.Path)
+		h.bool(t.Interpret)
+		h.string(fmt.Sprintf("%T", t.Source))
+		switch src := t.Source.(type) {
+		case *BlockNBTSource:
+			h.string(src.Pos)
+		case *EntityNBTSource:
+			h.string(src.Selector)
+		case *StorageNBTSource:
+			h.key(src.Storage)
+		}
+		h.component(t.Separator)
+	case *Object:
+		h.string(fmt.Sprintf("%T", t.Contents))
+		switch contents := t.Contents.(type) {
+		case *AtlasSprite:
+			h.key(contents.Atlas)
+			h.key(contents.Sprite)
+		case *PlayerHead:
+			h.string(contents.Profile.Name)
+			_, _ = h.Write(contents.Profile.Id[:])
+			h.bool(contents.NoHat)
+		}
+	case *Keybind:
+		h.string(t.Key)
+	}
+	h.style(c.Style())
+	children := c.Children()
+	h.uint64(uint64(len(children)))
+	for _, child := range children {
+		h.component(child)
+	}
+}
+
+func (h *hasher) style(s *Style) {
+	h.bool(!s.IsZero())
+	if s.IsZero() {
+		return
+	}
+	for _, d := range DecorationsOrder {
+		h.uint64(uint64(s.Decoration(d)))
+	}
+	h.key(s.Font)
+	h.bool(s.Color != nil)
+	if s.Color != nil {
+		h.string(s.Color.Hex())
+	}
+	h.bool(s.ShadowColor != nil)
+	if s.ShadowColor != nil {
+		h.uint64(uint64(*s.ShadowColor))
+	}
+	h.bool(s.Insertion != nil)
+	if s.Insertion != nil {
+		h.string(*s.Insertion)
+	}
+	h.bool(s.ClickEvent != nil)
+	if s.ClickEvent != nil {
+		h.string(s.ClickEvent.Action().Name())
+		h.string(s.ClickEvent.Value())
+	}
+	h.bool(s.HoverEvent != nil)
+	if s.HoverEvent != nil {
+		h.string(s.HoverEvent.Action().Name())
+		switch v := s.HoverEvent.Value().(type) {
+		case Component:
+			h.component(v)
+		case *ShowItemHoverType:
+			h.key(v.Item)
+			h.uint64(uint64(v.Count))
+		case *ShowEntityHoverType:
+			h.key(v.Type)
+			_, _ = h.Write(v.Id[:])
+			h.component(v.Name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+func equalTree() *Text {
+	insertion := "insert me"
+	return &Text{
+		Content: "Hello ",
+		S: Style{
+			Color:      color.Gold,
+			Bold:       True,
+			Font:       key.New(key.MinecraftNamespace, "default"),
+			Insertion:  &insertion,
+			ClickEvent: RunCommand("/spawn"),
+			HoverEvent: ShowText(&Text{Content: "Teleport"}),
+		},
+		Extra: []Component{
+			&Translation{Key: "greeting", With: []Component{&Primitive{Value: int64(3)}}},
+			&Selector{Pattern: "@p", Separator: &Text{Content: ", "}},
+			&Text{Content: "!", S: Style{Color: color.Gold}},
+		},
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a, b := equalTree(), equalTree()
+	require.True(t, Equal(a, b))
+	require.Equal(t, Hash(a), Hash(b))
+
+	// named and rgb colors of the same value are equal
+	b.S.Color = color.Gold.RGB
+	require.True(t, Equal(a, b))
+	require.Equal(t, Hash(a), Hash(b))
+
+	differ := []func(*Text){
+		func(t *Text) { t.Content = "Hi " },
+		func(t *Text) { t.S.Bold = False },
+		func(t *Text) { t.S.ClickEvent = RunCommand("/hub") },
+		func(t *Text) { t.S.HoverEvent = ShowText(&Text{Content: "Hub"}) },
+		func(t *Text) { t.Extra[0].(*Translation).With[0] = &Primitive{Value: int64(4)} },
+		func(t *Text) { t.Extra[1].(*Selector).Separator = nil },
+		func(t *Text) { t.Extra = t.Extra[:2] },
+	}
+	for i, modify := range differ {
+		b := equalTree()
+		modify(b)
+		require.False(t, Equal(a, b), "modification %d", i)
+		require.NotEqual(t, Hash(a), Hash(b), "modification %d", i)
+	}
+
+	require.True(t, Equal(nil, nil))
+	require.False(t, Equal(a, nil))
+}
+
+func TestEqualEffective(t *testing.T) {
+	a := equalTree()
+	b := equalTree()
+	// the last child repeats the inherited color
+	b.Extra[2].(*Text).S = Style{}
+	require.False(t, Equal(a, b))
+	require.True(t, EqualEffective(a, b))
+
+	b.Extra[2].(*Text).S = Style{Color: color.Red}
+	require.False(t, EqualEffective(a, b))
+}
+
+func TestHash_mapKey(t *testing.T) {
+	seen := map[uint64]Component{}
+	for _, c := range []Component{equalTree(), equalTree(), &Text{Content: "other"}} {
+		seen[Hash(c)] = c
+	}
+	require.Len(t, seen, 2)
+}
@@ -0,0 +1,179 @@
+package component
+
+import "fmt"
+
+// FlattenListener receives the events of a Flattener.
+type FlattenListener interface {
+	// PushStyle is called when entering a component with the component's own style.
+	PushStyle(s *Style)
+	// Text is called for every text rendered with the styles pushed so far.
+	Text(text string)
+	// PopStyle is called when leaving the component the style was pushed for.
+	PopStyle(s *Style)
+}
+
+// FlattenWriter is used by a FlattenHandler to render a component.
+type FlattenWriter interface {
+	// Text renders text with the style of the current component.
+	Text(text string)
+	// Component flattens a nested component, e.g. a translation argument,
+	// inheriting the style of the current component.
+	Component(c Component)
+}
+
+// FlattenHandler renders the content of a component, excluding its children.
+type FlattenHandler func(c Component, w FlattenWriter)
+
+// Flattener flattens component trees into a linear sequence of texts and style changes.
+//
+// The content of every component, excluding its children, is rendered by the handler of its type.
+// Nil handlers use the default handler of the type documented at each field.
+// The zero value is ready to use.
+type Flattener struct {
+	// The optional Translator to render Translation components with.
+	// Translations it does not know are rendered using their fallback or key.
+	Translator Translator
+
+	// Text renders the Content by default.
+	Text FlattenHandler
+	// Translation renders the translation format with the Translator by default.
+	// Since the children of a Translation are its arguments, the handler must flatten
+	// the arguments it renders itself.
+	Translation FlattenHandler
+	// Score renders the Value by default.
+	Score FlattenHandler
+	// Selector renders the Pattern by default.
+	Selector FlattenHandler
+	// Keybind renders the DisplayName by default.
+	Keybind FlattenHandler
+	// NBT renders nothing by default, since the value is resolved by the server.
+	NBT FlattenHandler
+	// Object renders nothing by default.
+	Object FlattenHandler
+	// Primitive renders the String by default.
+	Primitive FlattenHandler
+	// Unknown renders component types not known to this package.
+	// If nil, flattening fails with an error.
+	Unknown FlattenHandler
+}
+
+// Flatten flattens the component tree rooted at c into the listener.
+func (f *Flattener) Flatten(c Component, l FlattenListener) error {
+	fl := &flattening{f: f, l: l}
+	fl.Component(c)
+	return fl.err
+}
+
+// Run is a text with its effective style.
+type Run struct {
+	Text  string
+	Style Style
+}
+
+// Runs flattens the component tree rooted at c into runs of text with their effective style,
+// relative to c. Empty texts are skipped and adjacent texts with equal styles are merged.
+func (f *Flattener) Runs(c Component) ([]Run, error) {
+	l := &runsListener{styles: []Style{{}}}
+	err := f.Flatten(c, l)
+	return l.runs, err
+}
+
+type flattening struct {
+	f   *Flattener
+	l   FlattenListener
+	err error
+}
+
+var _ FlattenWriter = (*flattening)(nil)
+
+func (fl *flattening) Text(text string) {
+	fl.l.Text(text)
+}
+
+func (fl *flattening) Component(c Component) {
+	if c == nil || fl.err != nil {
+		return
+	}
+	handler := fl.handler(c)
+	if handler == nil {
+		fl.err = fmt.Errorf("unsupported component type %T", c)
+		return
+	}
+
+	s := c.Style()
+	fl.l.PushStyle(s)
+	handler(c, fl)
+	if _, ok := c.(*Translation); !ok {
+		for _, child := range c.Children() {
+			fl.Component(child)
+		}
+	}
+	fl.l.PopStyle(s)
+}
+
+func (fl *flattening) handler(c Component) FlattenHandler {
+	var h, def FlattenHandler
+	switch c.(type) {
+	case *Text:
+		h, def = fl.f.Text, flattenText
+	case *Translation:
+		h, def = fl.f.Translation, fl.f.flattenTranslation
+	case *Score:
+		h, def = fl.f.Score, flattenScore
+	case *Selector:
+		h, def = fl.f.Selector, flattenSelector
+	case *Keybind:
+		h, def = fl.f.Keybind, flattenKeybind
+	case *NBT:
+		h, def = fl.f.NBT, flattenNothing
+	case *Object:
+		h, def = fl.f.Object, flattenNothing
+	case *Primitive:
+		h, def = fl.f.Primitive, flattenPrimitive
+	default:
+		return fl.f.Unknown
+	}
+	if h != nil {
+		return h
+	}
+	return def
+}
+
+func flattenText(c Component, w FlattenWriter)      { w.Text(c.(*Text).Content) }
+func flattenScore(c Component, w FlattenWriter)     { w.Text(c.(*Score).Value) }
+func flattenSelector(c Component, w FlattenWriter)  { w.Text(c.(*Selector).Pattern) }
+func flattenKeybind(c Component, w FlattenWriter)   { w.Text(c.(*Keybind).DisplayName()) }
+func flattenPrimitive(c Component, w FlattenWriter) { w.Text(c.(*Primitive).String()) }
+func flattenNothing(Component, FlattenWriter)       {}
+
+func (f *Flattener) flattenTranslation(c Component, w FlattenWriter) {
+	c.(*Translation).Render(f.Translator, w.Text, w.Component)
+}
+
+// runsListener collects the runs of a Flattener.
+type runsListener struct {
+	styles []Style // stack of effective styles
+	runs   []Run
+}
+
+func (l *runsListener) PushStyle(s *Style) {
+	effective := *s
+	effective.Inherit(&l.styles[len(l.styles)-1])
+	l.styles = append(l.styles, effective)
+}
+
+func (l *runsListener) Text(text string) {
+	if text == "" {
+		return
+	}
+	s := l.styles[len(l.styles)-1]
+	if n := len(l.runs); n != 0 && l.runs[n-1].Style.Equal(&s) {
+		l.runs[n-1].Text += text
+		return
+	}
+	l.runs = append(l.runs, Run{Text: text, Style: s})
+}
+
+func (l *runsListener) PopStyle(*Style) {
+	l.styles = l.styles[:len(l.styles)-1]
+}
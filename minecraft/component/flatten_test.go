@@ -0,0 +1,92 @@
+package component_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+type unknownComponent struct{ Text }
+
+// eventRecorder records the events of a Flattener.
+type eventRecorder struct{ events []string }
+
+func (r *eventRecorder) PushStyle(s *Style) {
+	r.events = append(r.events, fmt.Sprintf("push %v", s.Color))
+}
+func (r *eventRecorder) Text(text string) { r.events = append(r.events, text) }
+func (r *eventRecorder) PopStyle(s *Style) {
+	r.events = append(r.events, fmt.Sprintf("pop %v", s.Color))
+}
+
+func TestFlattener_Runs(t *testing.T) {
+	tree := &Text{
+		Content: "Hi ",
+		S:       Style{Color: color.Gold},
+		Extra: []Component{
+			&Text{Content: "there", S: Style{Bold: True}},
+			&Text{Content: ", "},
+			&Translation{Key: "x", Fallback: "%s scored %s", S: Style{Italic: True}, With: []Component{
+				&Selector{Pattern: "@p", S: Style{Color: color.Red}},
+				&Score{Name: "@p", Objective: "kills", Value: "7"},
+			}},
+			&Text{Content: ". Press "},
+			&Keybind{Key: "key.jump"},
+			&NBT{Path: "Health", Source: &EntityNBTSource{Selector: "@s"}},
+		},
+	}
+	runs, err := (&Flattener{}).Runs(tree)
+	require.NoError(t, err)
+	require.Equal(t, []Run{
+		{Text: "Hi ", Style: Style{Color: color.Gold}},
+		{Text: "there", Style: Style{Color: color.Gold, Bold: True}},
+		{Text: ", ", Style: Style{Color: color.Gold}},
+		{Text: "@p", Style: Style{Color: color.Red, Italic: True}},
+		{Text: " scored 7", Style: Style{Color: color.Gold, Italic: True}},
+		{Text: ". Press Space", Style: Style{Color: color.Gold}},
+	}, runs)
+}
+
+func TestFlattener_handlers(t *testing.T) {
+	tree := &Text{
+		Content: "a",
+		Extra: []Component{
+			&Keybind{Key: "key.jump", Extra: []Component{&Text{Content: "b"}}},
+			&Translation{Key: "k", With: []Component{&Text{Content: "arg"}}},
+			&unknownComponent{Text{Content: "?"}},
+		},
+	}
+
+	f := &Flattener{}
+	_, err := f.Runs(tree)
+	require.EqualError(t, err, "unsupported component type *component_test.unknownComponent")
+
+	f = &Flattener{
+		Keybind: func(c Component, w FlattenWriter) {
+			w.Text("[" + c.(*Keybind).Key + "]")
+		},
+		Translation: func(c Component, w FlattenWriter) {
+			w.Text(c.(*Translation).Key + "(")
+			for _, arg := range c.(*Translation).With {
+				w.Component(arg)
+			}
+			w.Text(")")
+		},
+		Unknown: func(c Component, w FlattenWriter) {
+			w.Text(c.(*unknownComponent).Content)
+		},
+	}
+	r := new(eventRecorder)
+	require.NoError(t, f.Flatten(tree, r))
+	require.Equal(t, strings.Join([]string{
+		"push <nil>", "a",
+		"push <nil>", "[key.jump]", "push <nil>", "b", "pop <nil>", "pop <nil>",
+		"push <nil>", "k(", "push <nil>", "arg", "pop <nil>", ")", "pop <nil>",
+		"push <nil>", "?", "pop <nil>",
+		"pop <nil>",
+	}, "|"), strings.Join(r.events, "|"))
+}
@@ -41,6 +41,21 @@ func (h *hoverEvent) Value() interface{} {
 	return h.value
 }
 
+// mapShowText returns a copy of the show_text hover event e with its text replaced by fn.
+// Other hover events and hover events of other implementations are returned as is,
+// since they cannot be rebuilt without losing their type.
+func mapShowText(e HoverEvent, fn func(text Component) Component) HoverEvent {
+	h, ok := e.(*hoverEvent)
+	if !ok || h.action != ShowTextAction {
+		return e
+	}
+	text, ok := h.value.(Component)
+	if !ok || text == nil {
+		return e
+	}
+	return &hoverEvent{action: h.action, value: fn(text)}
+}
+
 type HoverAction interface {
 	Name() string
 	Type() ActionType
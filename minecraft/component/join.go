@@ -0,0 +1,87 @@
+package component
+
+// JoinConfig configures how Join joins components.
+// All fields are optional.
+type JoinConfig struct {
+	// Separator is put between items.
+	Separator Component
+	// LastSeparator is put between the last two items instead of Separator,
+	// e.g. " and " to produce "A, B and C". Nil uses Separator.
+	LastSeparator Component
+	// LastSeparatorIfSerial is put between the last two items instead of LastSeparator
+	// when joining more than two items, e.g. ", and " to produce "A, B, and C".
+	// Nil uses LastSeparator.
+	LastSeparatorIfSerial Component
+	// Prefix and Suffix are put before the first and after the last item.
+	Prefix, Suffix Component
+	// ItemStyle is applied to every item, fields already set by an item are kept.
+	ItemStyle *Style
+	// Transform is applied to every item before ItemStyle.
+	// Items it returns nil for are skipped.
+	Transform func(item Component) Component
+	// Empty is returned when there are no items to join.
+	// Nil returns an empty Text.
+	Empty Component
+}
+
+// Join joins the items with the given separator.
+func Join(separator Component, items ...Component) Component {
+	return JoinConfig{Separator: separator}.Join(items...)
+}
+
+// Join joins the items into a single Text component. Nil items are skipped.
+// The items and separators are cloned and the returned component shares no
+// nodes with them.
+func (j JoinConfig) Join(items ...Component) Component {
+	joined := make([]Component, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item = Clone(item)
+		if j.Transform != nil {
+			if item = j.Transform(item); item == nil {
+				continue
+			}
+		}
+		if j.ItemStyle != nil {
+			item.Style().Merge(j.ItemStyle, MergeIfUnset, MergeAll)
+		}
+		joined = append(joined, item)
+	}
+	if len(joined) == 0 {
+		if j.Empty == nil {
+			return &Text{}
+		}
+		return Clone(j.Empty)
+	}
+
+	lastSeparator := j.LastSeparator
+	if lastSeparator == nil {
+		lastSeparator = j.Separator
+	}
+	if j.LastSeparatorIfSerial != nil && len(joined) > 2 {
+		lastSeparator = j.LastSeparatorIfSerial
+	}
+
+	extra := make([]Component, 0, 2*len(joined)+1)
+	if j.Prefix != nil {
+		extra = append(extra, Clone(j.Prefix))
+	}
+	for i, item := range joined {
+		if i != 0 {
+			separator := j.Separator
+			if i == len(joined)-1 {
+				separator = lastSeparator
+			}
+			if separator != nil {
+				extra = append(extra, Clone(separator))
+			}
+		}
+		extra = append(extra, item)
+	}
+	if j.Suffix != nil {
+		extra = append(extra, Clone(j.Suffix))
+	}
+	return &Text{Extra: extra}
+}
@@ -0,0 +1,75 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+)
+
+func TestJoin(t *testing.T) {
+	items := func(names ...string) []Component {
+		var cs []Component
+		for _, name := range names {
+			cs = append(cs, &Text{Content: name})
+		}
+		return cs
+	}
+	plain := func(c Component) string {
+		b := new(strings.Builder)
+		require.NoError(t, (&codec.Plain{}).Marshal(b, c))
+		return b.String()
+	}
+
+	j := JoinConfig{
+		Separator:     &Text{Content: ", "},
+		LastSeparator: &Text{Content: " and "},
+		Prefix:        &Text{Content: "["},
+		Suffix:        &Text{Content: "]"},
+		Empty:         &Text{Content: "nobody"},
+	}
+	require.Equal(t, "nobody", plain(j.Join()))
+	require.Equal(t, "[A]", plain(j.Join(items("A")...)))
+	require.Equal(t, "[A and B]", plain(j.Join(items("A", "B")...)))
+	require.Equal(t, "[A, B and C]", plain(j.Join(append(items("A", "B"), nil, &Text{Content: "C"})...)))
+
+	j.LastSeparatorIfSerial = &Text{Content: ", and "}
+	require.Equal(t, "[A and B]", plain(j.Join(items("A", "B")...)))
+	require.Equal(t, "[A, B, and C]", plain(j.Join(items("A", "B", "C")...)))
+
+	require.Equal(t, "A|B", plain(Join(&Text{Content: "|"}, items("A", "B")...)))
+	require.Equal(t, "", plain(Join(&Text{Content: "|"})))
+}
+
+func TestJoin_style(t *testing.T) {
+	j := JoinConfig{
+		Separator: &Text{Content: ", "},
+		ItemStyle: &Style{Color: color.Gold, Bold: True},
+		Transform: func(item Component) Component {
+			if item.(*Text).Content == "skip" {
+				return nil
+			}
+			return item
+		},
+	}
+	first := &Text{Content: "A", S: Style{Color: color.Red}}
+	joined := j.Join(first, &Text{Content: "skip"}, &Text{Content: "B"})
+	require.Equal(t, color.Red, first.S.Color)
+	require.Equal(t, NotSet, first.S.Bold, "items must not be modified")
+
+	b := new(strings.Builder)
+	require.NoError(t, (&legacy.Legacy{}).Marshal(b, joined))
+	require.Equal(t, "§c§lA§r, §6§lB", b.String())
+
+	b.Reset()
+	require.NoError(t, codec.JsonModern.Marshal(b, joined))
+	require.Equal(t, `{"extra":[{"bold":true,"color":"#ff5555","text":"A"},{"text":", "},{"bold":true,"color":"#ffaa00","text":"B"}],"text":""}`, b.String())
+
+	b.Reset()
+	require.NoError(t, (&legacy.Legacy{}).Marshal(b, JoinConfig{ItemStyle: &Style{Color: color.Gold}}.Join(&Primitive{Value: int64(3)})))
+	require.Equal(t, "§63", b.String())
+}
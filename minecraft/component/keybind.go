@@ -0,0 +1,64 @@
+package component
+
+// KeybindNames maps the vanilla keybind identifiers to the display
+// names of the keys they are bound to by default.
+var KeybindNames = map[string]string{
+	// Movement
+	"key.forward": "W",
+	"key.left":    "A",
+	"key.back":    "S",
+	"key.right":   "D",
+	"key.jump":    "Space",
+	"key.sneak":   "Left Shift",
+	"key.sprint":  "Left Control",
+
+	// Gameplay
+	"key.attack":   "Left Button",
+	"key.use":      "Right Button",
+	"key.pickItem": "Middle Button",
+
+	// Inventory
+	"key.drop":                 "Q",
+	"key.inventory":            "E",
+	"key.swapOffhand":          "F",
+	"key.hotbar.1":             "1",
+	"key.hotbar.2":             "2",
+	"key.hotbar.3":             "3",
+	"key.hotbar.4":             "4",
+	"key.hotbar.5":             "5",
+	"key.hotbar.6":             "6",
+	"key.hotbar.7":             "7",
+	"key.hotbar.8":             "8",
+	"key.hotbar.9":             "9",
+	"key.saveToolbarActivator": "C",
+	"key.loadToolbarActivator": "X",
+
+	// Multiplayer
+	"key.chat":               "T",
+	"key.playerlist":         "Tab",
+	"key.command":            "/",
+	"key.socialInteractions": "P",
+
+	// Miscellaneous
+	"key.screenshot":        "F2",
+	"key.togglePerspective": "F5",
+	"key.smoothCamera":      "Not Bound",
+	"key.fullscreen":        "F11",
+	"key.spectatorOutlines": "Not Bound",
+	"key.advancements":      "L",
+	"key.quickActions":      "G",
+}
+
+// KeybindName returns the default display name of the key bound to the keybind identifier,
+// or the identifier itself if it is unknown.
+func KeybindName(keybind string) string {
+	if name, ok := KeybindNames[keybind]; ok {
+		return name
+	}
+	return keybind
+}
+
+// DisplayName returns the default display name of the key bound to k.
+func (k *Keybind) DisplayName() string {
+	return KeybindName(k.Key)
+}
@@ -0,0 +1,34 @@
+package component
+
+import "go.minekube.com/common/minecraft/key"
+
+// NBTSource is the source an NBT component reads its data from.
+// Use one of BlockNBTSource, EntityNBTSource or StorageNBTSource.
+type NBTSource interface {
+	nbtSource()
+}
+
+var (
+	_ NBTSource = (*BlockNBTSource)(nil)
+	_ NBTSource = (*EntityNBTSource)(nil)
+	_ NBTSource = (*StorageNBTSource)(nil)
+)
+
+// BlockNBTSource reads NBT data from the block entity at a position.
+type BlockNBTSource struct {
+	Pos string // The block position, in command coordinates (e.g. "~ ~-1 ~" or "10 64 -3").
+}
+
+// EntityNBTSource reads NBT data from the entities matched by an entity selector.
+type EntityNBTSource struct {
+	Selector string // The entity selector pattern (e.g. "@p").
+}
+
+// StorageNBTSource reads NBT data from a command storage.
+type StorageNBTSource struct {
+	Storage key.Key // The command storage identifier.
+}
+
+func (*BlockNBTSource) nbtSource()   {}
+func (*EntityNBTSource) nbtSource()  {}
+func (*StorageNBTSource) nbtSource() {}
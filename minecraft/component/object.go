@@ -0,0 +1,52 @@
+package component
+
+import (
+	"github.com/google/uuid"
+	"go.minekube.com/common/minecraft/key"
+)
+
+var (
+	// BlocksAtlas is the default atlas of AtlasSprite.
+	BlocksAtlas = key.New(key.MinecraftNamespace, "blocks")
+)
+
+// ObjectContents is the object displayed by an Object component.
+// Use one of AtlasSprite or PlayerHead.
+type ObjectContents interface {
+	objectContents()
+}
+
+var (
+	_ ObjectContents = (*AtlasSprite)(nil)
+	_ ObjectContents = (*PlayerHead)(nil)
+)
+
+// AtlasSprite displays a sprite from a texture atlas.
+type AtlasSprite struct {
+	Atlas  key.Key // The texture atlas, nil for the default BlocksAtlas.
+	Sprite key.Key // The sprite within the atlas (e.g. "minecraft:block/stone").
+}
+
+// PlayerHead displays the head of a player skin.
+type PlayerHead struct {
+	Profile PlayerProfile // The profile of the player whose skin is displayed.
+	NoHat   bool          // Whether to hide the hat layer of the skin.
+}
+
+// PlayerProfile identifies a player by name, id and/or its profile properties (e.g. "textures").
+// Any of the fields may be left unset.
+type PlayerProfile struct {
+	Name       string
+	Id         uuid.UUID
+	Properties []ProfileProperty
+}
+
+// ProfileProperty is a property of a PlayerProfile.
+type ProfileProperty struct {
+	Name      string
+	Value     string
+	Signature string // The optional signature of the property value.
+}
+
+func (*AtlasSprite) objectContents() {}
+func (*PlayerHead) objectContents()  {}
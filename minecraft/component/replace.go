@@ -0,0 +1,264 @@
+package component
+
+import "regexp"
+
+// Replacer replaces text matching a pattern in component trees
+// while keeping the styling of the surrounding text.
+//
+// Matches may span several nested Text components, but never cross
+// other components such as a Translation or Keybind.
+// A Replacer is safe for concurrent use if its ReplaceFunc is.
+type Replacer struct {
+	// Pattern matches the text to replace.
+	Pattern *regexp.Regexp
+	// Replacement replaces every match and is cloned for every use.
+	// It inherits the style of the text at the start of the match.
+	Replacement Component
+	// ReplaceFunc returns the replacement of a match and takes precedence over Replacement.
+	// The returned component is cloned, returning nil keeps the matched text.
+	ReplaceFunc func(m *Match) Component
+	// Limit is the maximum number of replacements, zero means no limit.
+	Limit int
+	// Translations enables replacing text in Translation arguments.
+	Translations bool
+	// HoverText enables replacing text in show text hover events
+	// created by this package, other HoverEvent implementations are kept as is.
+	HoverText bool
+}
+
+// Match is a match of a Replacer.
+type Match struct {
+	// Text is the matched text.
+	Text string
+	// Submatches are the texts of the regular expression submatches,
+	// Submatches[0] is the whole match.
+	Submatches []string
+	// Style is the effective style of the text at the start of the match,
+	// relative to the outermost Text of the tree of Text components containing it.
+	// That is the component passed to Replacer.Replace, unless the text is nested under
+	// another component such as a Translation argument, a Keybind child or a hover text,
+	// whose styles are not included.
+	Style Style
+}
+
+// ReplaceLiteral returns a Replacer replacing all occurrences of literal with replacement.
+func ReplaceLiteral(literal string, replacement Component) *Replacer {
+	return &Replacer{Pattern: regexp.MustCompile(regexp.QuoteMeta(literal)), Replacement: replacement}
+}
+
+// ReplaceRegexp returns a Replacer replacing all matches of pattern with replacement.
+func ReplaceRegexp(pattern *regexp.Regexp, replacement Component) *Replacer {
+	return &Replacer{Pattern: pattern, Replacement: replacement}
+}
+
+// Replace returns a copy of c with the matches replaced.
+// The tree rooted at c is not modified.
+//
+// Text trees containing a replacement are rebuilt as a flat list
+// of text components carrying their effective style.
+func (r *Replacer) Replace(c Component) Component {
+	if c == nil || r.Pattern == nil {
+		return c
+	}
+	s := &replacing{Replacer: r, remaining: r.Limit}
+	return s.replace(Clone(c))
+}
+
+// replacing is the state of a single Replacer.Replace call.
+type replacing struct {
+	*Replacer
+	remaining int
+}
+
+func (r *replacing) done() bool {
+	return r.Limit > 0 && r.remaining <= 0
+}
+
+// replace replaces in the owned component c.
+func (r *replacing) replace(c Component) Component {
+	r.replaceHover(c.Style())
+	switch c := c.(type) {
+	case *Text:
+		return r.replaceText(c)
+	case *Translation:
+		if r.Translations {
+			for i, arg := range c.With {
+				if arg != nil {
+					c.With[i] = r.replace(arg)
+				}
+			}
+		}
+		return c
+	default:
+		children := c.Children()
+		for i, child := range children {
+			if child != nil {
+				children[i] = r.replace(child)
+			}
+		}
+		return c
+	}
+}
+
+func (r *replacing) replaceHover(s *Style) {
+	if r.HoverText {
+		s.HoverEvent = mapShowText(s.HoverEvent, r.replace)
+	}
+}
+
+// replaceItem is either text or a component that is not a Text.
+type replaceItem struct {
+	text  string
+	c     Component
+	style Style // effective style of the text or the parent of c
+}
+
+// replacement is a match to replace.
+type replacement struct {
+	start, end int // byte offsets into the text of a segment
+	c          Component
+}
+
+func (r *replacing) replaceText(root *Text) Component {
+	var items []replaceItem
+	r.collect(root, &Style{}, &items, true)
+
+	// find the replacements in every segment of consecutive texts
+	replacements := make([][]replacement, len(items))
+	var found bool
+	for start := 0; start < len(items); {
+		if items[start].c != nil {
+			start++
+			continue
+		}
+		end := start
+		var text string
+		for ; end < len(items) && items[end].c == nil; end++ {
+			text += items[end].text
+		}
+		replacements[start] = r.find(text, items[start:end])
+		found = found || len(replacements[start]) != 0
+		start = end
+	}
+	if !found {
+		return root
+	}
+
+	var pieces []Component
+	for start := 0; start < len(items); {
+		if c := items[start].c; c != nil {
+			c.Style().Inherit(&items[start].style)
+			pieces = append(pieces, c)
+			start++
+			continue
+		}
+		end := start
+		for end < len(items) && items[end].c == nil {
+			end++
+		}
+		pieces = appendSegment(pieces, items[start:end], replacements[start])
+		start = end
+	}
+	return &Text{Extra: pieces}
+}
+
+// collect collects the texts of the Text tree rooted at t and replaces in all other components.
+func (r *replacing) collect(t *Text, parent *Style, items *[]replaceItem, root bool) {
+	if !root {
+		r.replaceHover(&t.S)
+	}
+	effective := t.S
+	effective.Inherit(parent)
+	*items = append(*items, replaceItem{text: t.Content, style: effective})
+	for i, child := range t.Extra {
+		if child == nil {
+			continue
+		}
+		if text, ok := child.(*Text); ok {
+			r.collect(text, &effective, items, false)
+			continue
+		}
+		child = r.replace(child)
+		t.Extra[i] = child
+		*items = append(*items, replaceItem{c: child, style: effective})
+	}
+}
+
+// find returns the replacements in the text of the segment.
+func (r *replacing) find(text string, segment []replaceItem) []replacement {
+	var found []replacement
+	for _, loc := range r.Pattern.FindAllStringSubmatchIndex(text, -1) {
+		if r.done() {
+			break
+		}
+		if loc[0] == loc[1] {
+			continue // ignore empty matches
+		}
+		m := &Match{
+			Text:       text[loc[0]:loc[1]],
+			Submatches: make([]string, len(loc)/2),
+			Style:      styleAt(segment, loc[0]),
+		}
+		for i := range m.Submatches {
+			if loc[2*i] >= 0 {
+				m.Submatches[i] = text[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		var c Component
+		if r.ReplaceFunc != nil {
+			c = Clone(r.ReplaceFunc(m))
+		} else if r.Replacement != nil {
+			c = Clone(r.Replacement)
+		}
+		if c == nil {
+			continue
+		}
+		c.Style().Inherit(&m.Style)
+		found = append(found, replacement{start: loc[0], end: loc[1], c: c})
+		r.remaining--
+	}
+	return found
+}
+
+// styleAt returns the style of the text at the offset into the segment.
+func styleAt(segment []replaceItem, offset int) Style {
+	for _, item := range segment {
+		if offset < len(item.text) {
+			return item.style
+		}
+		offset -= len(item.text)
+	}
+	return segment[len(segment)-1].style
+}
+
+// appendSegment appends the texts of the segment with the replacements applied.
+func appendSegment(pieces []Component, segment []replaceItem, replacements []replacement) []Component {
+	// appendRange appends the unchanged text between start and end.
+	appendRange := func(start, end int) {
+		var offset int
+		for _, item := range segment {
+			from, to := start-offset, end-offset
+			offset += len(item.text)
+			if from < 0 {
+				from = 0
+			}
+			if to > len(item.text) {
+				to = len(item.text)
+			}
+			if from < to {
+				pieces = append(pieces, &Text{Content: item.text[from:to], S: item.style})
+			}
+		}
+	}
+	var pos, length int
+	for _, item := range segment {
+		length += len(item.text)
+	}
+	for _, rep := range replacements {
+		appendRange(pos, rep.start)
+		pieces = append(pieces, rep.c)
+		pos = rep.end
+	}
+	appendRange(pos, length)
+	return pieces
+}
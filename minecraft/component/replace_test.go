@@ -0,0 +1,111 @@
+package component_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+)
+
+func TestReplacer(t *testing.T) {
+	l := &legacy.Legacy{}
+	render := func(c Component) string {
+		b := new(strings.Builder)
+		require.NoError(t, l.Marshal(b, c))
+		return b.String()
+	}
+
+	tree := &Text{
+		Content: "Hello Bob",
+		S:       Style{Color: color.Gray},
+		Extra: []Component{
+			&Text{Content: ", bye Bo", S: Style{Italic: True}},
+			&Text{Content: "b!", S: Style{Italic: True}},
+		},
+	}
+	before := render(tree)
+
+	// replaces within a single text
+	r := ReplaceLiteral("Hello", &Text{Content: "Hi", S: Style{Bold: True}})
+	require.Equal(t, "§7§lHi§7 Bob§7§o, bye Bob!", render(r.Replace(tree)))
+	require.Equal(t, before, render(tree), "original tree must not be modified")
+
+	// replaces across texts
+	r = ReplaceRegexp(regexp.MustCompile(`B(o)b`), nil)
+	r.ReplaceFunc = func(m *Match) Component {
+		require.Equal(t, []string{"Bob", "o"}, m.Submatches)
+		return &Text{Content: strings.ToUpper(m.Text), S: Style{Color: color.Gold}}
+	}
+	require.Equal(t, "§7Hello §6BOB§7§o, bye §6§oBOB§7§o!", render(r.Replace(tree)))
+
+	// limit
+	r.Limit = 1
+	require.Equal(t, "§7Hello §6BOB§7§o, bye Bob!", render(r.Replace(tree)))
+
+	// no match leaves the tree as is
+	require.True(t, Equal(tree, ReplaceLiteral("Alice", &Text{}).Replace(tree)))
+
+	// a nil replacement keeps the text
+	r = ReplaceLiteral("Bob", nil)
+	r.ReplaceFunc = func(m *Match) Component { return nil }
+	require.True(t, Equal(tree, r.Replace(tree)))
+
+	// primitives inherit the style of the match
+	require.Equal(t, "§7Hello 7§7§o, bye 7!", render(ReplaceLiteral("Bob", &Primitive{Value: int64(7)}).Replace(tree)))
+
+	// the replacement returned by ReplaceFunc is not modified
+	shared := &Text{Content: "Alice"}
+	r.ReplaceFunc = func(*Match) Component { return shared }
+	require.Equal(t, "§7Hello Alice§7§o, bye Alice!", render(r.Replace(tree)))
+	require.Equal(t, &Text{Content: "Alice"}, shared)
+}
+
+func TestReplacer_barriers(t *testing.T) {
+	tree := &Text{
+		Content: "a",
+		S:       Style{HoverEvent: ShowText(&Text{Content: "a"})},
+		Extra: []Component{
+			&Keybind{Key: "key.jump", S: Style{Color: color.Red}, Extra: []Component{&Text{Content: "a"}}},
+			&Text{Content: "a"},
+			&Translation{Key: "x", With: []Component{&Text{Content: "a"}}},
+		},
+	}
+	r := ReplaceLiteral("aa", &Text{Content: "b"})
+	require.True(t, Equal(tree, r.Replace(tree)), "must not match across other components")
+
+	r = ReplaceLiteral("a", &Text{Content: "b"})
+	replaced := r.Replace(tree)
+	var texts []string
+	Visit(replaced, func(n *Node) VisitResult {
+		if text, ok := n.Component.(*Text); ok && text.Content != "" {
+			texts = append(texts, text.Content)
+		}
+		return VisitContinue
+	})
+	require.Equal(t, []string{"b", "b", "b", "a"}, texts)
+	require.Equal(t, &Text{Content: "a"}, replaced.Children()[0].Style().HoverEvent.Value())
+
+	r.Translations = true
+	r.HoverText = true
+	replaced = r.Replace(tree)
+	texts = nil
+	Visit(replaced, func(n *Node) VisitResult {
+		if text, ok := n.Component.(*Text); ok && text.Content != "" {
+			texts = append(texts, text.Content)
+		}
+		return VisitContinue
+	})
+	require.Equal(t, []string{"b", "b", "b", "b"}, texts)
+	require.True(t, Equal(&Text{Extra: []Component{&Text{Content: "b"}}},
+		replaced.Children()[0].Style().HoverEvent.Value().(Component)))
+
+	// other hover event implementations are kept as is
+	custom := &customHover{text: &Text{Content: "aa"}}
+	replaced = r.Replace(&Text{Content: "x", S: Style{HoverEvent: custom}})
+	require.Same(t, custom, replaced.Style().HoverEvent)
+	require.Equal(t, &Text{Content: "aa"}, custom.text)
+}
@@ -7,17 +7,24 @@ import (
 
 var (
 	DefaultFont = key.New(key.MinecraftNamespace, "default")
+	// UniformFont is the font rendering all text with the unicode font.
+	UniformFont = key.New(key.MinecraftNamespace, "uniform")
+	// AltFont is the font of the enchanting table (Standard Galactic Alphabet).
+	AltFont = key.New(key.MinecraftNamespace, "alt")
+	// IllagerAltFont is the font of the illager runes (Illageralt).
+	IllagerAltFont = key.New(key.MinecraftNamespace, "illageralt")
 )
 
 // Style is the style of a Component.
 type Style struct {
 	Obfuscated, Bold, Strikethrough, Underlined, Italic State
 
-	Font       key.Key
-	Color      color.Color
-	ClickEvent ClickEvent
-	HoverEvent HoverEvent
-	Insertion  *string // Gets the string to be inserted when this component is shift-clicked.
+	Font        key.Key
+	Color       color.Color
+	ShadowColor *color.ARGB // The text shadow color, nil for the default shadow.
+	ClickEvent  ClickEvent
+	HoverEvent  HoverEvent
+	Insertion   *string // Gets the string to be inserted when this component is shift-clicked.
 }
 
 // IsZero reports whether the Style is the zero value.
@@ -30,6 +37,7 @@ func (s *Style) IsZero() bool {
 			s.Italic == NotSet &&
 			s.Font == nil &&
 			s.Color == nil &&
+			s.ShadowColor == nil &&
 			s.ClickEvent == nil &&
 			s.HoverEvent == nil &&
 			s.Insertion == nil)
@@ -67,6 +75,107 @@ func (s *Style) SetDecoration(decoration Decoration, state State) {
 	}
 }
 
+// Merge is a set of Style fields to merge.
+type Merge uint8
+
+// Style fields to merge.
+const (
+	// MergeColor merges the Color and ShadowColor.
+	MergeColor Merge = 1 << iota
+	// MergeDecorations merges all decorations.
+	MergeDecorations
+	// MergeEvents merges the ClickEvent and HoverEvent.
+	MergeEvents
+	// MergeInsertion merges the Insertion.
+	MergeInsertion
+	// MergeFont merges the Font.
+	MergeFont
+
+	// MergeAll merges all fields.
+	MergeAll = MergeColor | MergeDecorations | MergeEvents | MergeInsertion | MergeFont
+)
+
+// Has reports whether the set contains all fields of m2.
+func (m Merge) Has(m2 Merge) bool {
+	return m&m2 == m2
+}
+
+// MergeStrategy decides which fields of a Style are overwritten when merging.
+type MergeStrategy uint8
+
+const (
+	// MergeAlways overwrites fields with the fields that are set on the other Style.
+	MergeAlways MergeStrategy = iota
+	// MergeIfUnset only sets fields that are not yet set on the target Style.
+	MergeIfUnset
+)
+
+// Merge merges the fields of that into s.
+// Only the fields in merges that are set on that are merged, depending on the strategy.
+func (s *Style) Merge(that *Style, strategy MergeStrategy, merges Merge) {
+	if that == nil {
+		return
+	}
+	always := strategy == MergeAlways
+	if merges.Has(MergeColor) {
+		if that.Color != nil && (always || s.Color == nil) {
+			s.Color = that.Color
+		}
+		if that.ShadowColor != nil && (always || s.ShadowColor == nil) {
+			s.ShadowColor = that.ShadowColor
+		}
+	}
+	if merges.Has(MergeDecorations) {
+		for _, d := range DecorationsOrder {
+			if state := that.Decoration(d); state != NotSet && (always || s.Decoration(d) == NotSet) {
+				s.SetDecoration(d, state)
+			}
+		}
+	}
+	if merges.Has(MergeEvents) {
+		if that.ClickEvent != nil && (always || s.ClickEvent == nil) {
+			s.ClickEvent = that.ClickEvent
+		}
+		if that.HoverEvent != nil && (always || s.HoverEvent == nil) {
+			s.HoverEvent = that.HoverEvent
+		}
+	}
+	if merges.Has(MergeInsertion) {
+		if that.Insertion != nil && (always || s.Insertion == nil) {
+			s.Insertion = that.Insertion
+		}
+	}
+	if merges.Has(MergeFont) {
+		if that.Font != nil && (always || s.Font == nil) {
+			s.Font = that.Font
+		}
+	}
+}
+
+// Inherit sets all fields of s that are unset to the fields of the parent Style,
+// the way Minecraft applies the style of a component to its children.
+// The parent may be nil.
+func (s *Style) Inherit(parent *Style) {
+	s.Merge(parent, MergeIfUnset, MergeAll)
+}
+
+// WalkEffectiveStyles walks the component tree depth-first and calls fn for each component
+// with its effective style, that is its style inheriting from all its ancestors.
+// The root component inherits from parent, which may be nil.
+//
+// The children of a Translation are its arguments, which inherit the style of the Translation.
+func WalkEffectiveStyles(c Component, parent *Style, fn func(c Component, effective *Style)) {
+	if c == nil {
+		return
+	}
+	root := &Node{Component: c, Style: *c.Style()}
+	root.Style.Inherit(parent)
+	visit(root, func(n *Node) VisitResult {
+		fn(n.Component, &n.Style)
+		return VisitContinue
+	})
+}
+
 // State is a tri-state.
 type State uint8
 
@@ -0,0 +1,86 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+func TestStyle_Merge(t *testing.T) {
+	insertion := "insert me"
+	theme := Style{
+		Color:      color.Gold,
+		Bold:       True,
+		Italic:     False,
+		ClickEvent: RunCommand("/spawn"),
+		HoverEvent: ShowText(&Text{Content: "Teleport"}),
+		Insertion:  &insertion,
+		Font:       DefaultFont,
+	}
+	user := func() Style {
+		return Style{Color: color.Red, Italic: True, ClickEvent: OpenUrl("https://example.com")}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		s := user()
+		s.Merge(&theme, MergeAlways, MergeAll)
+		require.Equal(t, theme, s)
+	})
+	t.Run("unset", func(t *testing.T) {
+		s := user()
+		s.Merge(&theme, MergeIfUnset, MergeAll)
+		require.Equal(t, Style{
+			Color:      color.Red,
+			Bold:       True,
+			Italic:     True,
+			ClickEvent: s.ClickEvent,
+			HoverEvent: theme.HoverEvent,
+			Insertion:  &insertion,
+			Font:       DefaultFont,
+		}, s)
+		require.Equal(t, "https://example.com", s.ClickEvent.Value())
+	})
+	t.Run("colors", func(t *testing.T) {
+		s := user()
+		s.Merge(&theme, MergeAlways, MergeColor)
+		exp := user()
+		exp.Color = color.Gold
+		require.Equal(t, exp, s)
+	})
+	t.Run("events", func(t *testing.T) {
+		s := user()
+		s.Merge(&theme, MergeAlways, MergeEvents)
+		exp := user()
+		exp.ClickEvent = theme.ClickEvent
+		exp.HoverEvent = theme.HoverEvent
+		require.Equal(t, exp, s)
+	})
+	t.Run("nil", func(t *testing.T) {
+		s := user()
+		s.Inherit(nil)
+		require.Equal(t, user(), s)
+	})
+}
+
+func TestWalkEffectiveStyles(t *testing.T) {
+	arg := &Text{Content: "Steve", S: Style{Italic: True}}
+	leaf := &Text{Content: "!", S: Style{Bold: False}}
+	root := &Text{
+		S: Style{Color: color.Gold, Bold: True},
+		Extra: []Component{
+			&Translation{Key: "welcome", S: Style{Color: color.Red}, With: []Component{arg}},
+			leaf,
+		},
+	}
+
+	effective := map[Component]Style{}
+	WalkEffectiveStyles(root, nil, func(c Component, s *Style) {
+		effective[c] = *s
+	})
+	require.Len(t, effective, 4)
+	require.Equal(t, Style{Color: color.Gold, Bold: True}, effective[root])
+	require.Equal(t, Style{Color: color.Red, Bold: True, Italic: True}, effective[arg])
+	require.Equal(t, Style{Color: color.Gold, Bold: False}, effective[leaf])
+}
@@ -0,0 +1,103 @@
+package component
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Translator resolves translation keys to format strings.
+type Translator interface {
+	// Translate returns the format string of the translation key and whether the key is known.
+	//
+	// Format strings use the Minecraft language file syntax, where "%s" refers to the next
+	// argument, "%1$s" to the first argument and "%%" is a literal percent sign.
+	Translate(key string) (format string, ok bool)
+}
+
+// TranslatorFunc is a function implementing Translator.
+type TranslatorFunc func(key string) (format string, ok bool)
+
+// Translate implements Translator.
+func (f TranslatorFunc) Translate(key string) (string, bool) {
+	return f(key)
+}
+
+// Format returns the format string the Translation is rendered with.
+// This is the format known by the Translator (that may be nil),
+// the Fallback if the key is unknown, or the Key itself if there is no fallback.
+func (t *Translation) Format(tr Translator) string {
+	if tr != nil {
+		if format, ok := tr.Translate(t.Key); ok {
+			return format
+		}
+	}
+	if t.Fallback != "" {
+		return t.Fallback
+	}
+	return t.Key
+}
+
+// Render renders the Translation using the format returned by Format.
+// It calls text for each literal text segment and arg for each argument referenced
+// by the format, in order. Placeholders referring to missing arguments are rendered as is.
+func (t *Translation) Render(tr Translator, text func(string), arg func(Component)) {
+	format := t.Format(tr)
+	var (
+		next int // next sequential argument index
+		lit  strings.Builder
+	)
+	flush := func() {
+		if lit.Len() != 0 {
+			text(lit.String())
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if format[i+1] == '%' {
+			lit.WriteByte('%')
+			i++
+			continue
+		}
+		// parse "%s" or "%<n>$s"
+		var (
+			index    int
+			explicit bool
+			end      = i + 1
+		)
+		for end < len(format) && format[end] >= '0' && format[end] <= '9' {
+			end++
+		}
+		if end > i+1 && end+1 < len(format) && format[end] == '$' {
+			n, err := strconv.Atoi(format[i+1 : end])
+			if err != nil || n < 1 {
+				lit.WriteByte(format[i])
+				continue
+			}
+			index, explicit = n-1, true
+			end++
+		} else if end != i+1 {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if format[end] != 's' && format[end] != 'd' {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if !explicit {
+			index = next
+			next++
+		}
+		if index >= len(t.With) {
+			lit.WriteString(format[i : end+1])
+		} else {
+			flush()
+			arg(t.With[index])
+		}
+		i = end
+	}
+	flush()
+}
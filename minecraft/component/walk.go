@@ -0,0 +1,121 @@
+package component
+
+// VisitResult decides how Visit continues after visiting a component.
+type VisitResult uint8
+
+const (
+	// VisitContinue continues with the children of the visited component.
+	VisitContinue VisitResult = iota
+	// VisitSkipChildren continues with the next sibling of the visited component, skipping its children.
+	VisitSkipChildren
+	// VisitStop stops visiting.
+	VisitStop
+)
+
+// Node is a component visited by Visit.
+type Node struct {
+	Component Component
+	Parent    *Node // The parent node, nil for the root.
+	Index     int   // The index of the component in the children of the parent.
+	Depth     int   // The depth in the tree, 0 for the root.
+	Style     Style // The effective style of the component, inheriting from all its ancestors.
+}
+
+// Visit walks the component tree rooted at c depth-first and calls fn for each
+// component before its children. It reports whether the whole tree was walked,
+// that is fn never returned VisitStop.
+//
+// The children of a Translation are its arguments.
+func Visit(c Component, fn func(n *Node) VisitResult) bool {
+	if c == nil {
+		return true
+	}
+	return visit(&Node{Component: c, Style: *c.Style()}, fn)
+}
+
+func visit(n *Node, fn func(n *Node) VisitResult) bool {
+	switch fn(n) {
+	case VisitStop:
+		return false
+	case VisitSkipChildren:
+		return true
+	}
+	for i, child := range n.Component.Children() {
+		if child == nil {
+			continue
+		}
+		childNode := &Node{
+			Component: child,
+			Parent:    n,
+			Index:     i,
+			Depth:     n.Depth + 1,
+			Style:     *child.Style(),
+		}
+		childNode.Style.Inherit(&n.Style)
+		if !visit(childNode, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform returns a new tree built by replacing each component of the tree rooted at c
+// with the result of fn. The tree rooted at c is not modified.
+//
+// The tree is transformed bottom-up: fn is called with a shallow copy of each component
+// whose children were already transformed. It may modify and return the copy, return another
+// component, or return nil to remove the component from its parent.
+// Components of unknown types are passed to fn as is and their children are not transformed.
+//
+// The children of a Translation are its arguments.
+func Transform(c Component, fn func(c Component) Component) Component {
+	if c == nil {
+		return nil
+	}
+	cp, ok := shallowCopy(c)
+	if !ok {
+		return fn(c)
+	}
+	if children := cp.Children(); len(children) != 0 {
+		transformed := make([]Component, 0, len(children))
+		for _, child := range children {
+			if t := Transform(child, fn); t != nil {
+				transformed = append(transformed, t)
+			}
+		}
+		cp.SetChildren(transformed)
+	}
+	return fn(cp)
+}
+
+// shallowCopy returns a copy of c sharing its fields, or false if the type of c is unknown.
+func shallowCopy(c Component) (Component, bool) {
+	switch t := c.(type) {
+	case *Text:
+		cp := *t
+		return &cp, true
+	case *Translation:
+		cp := *t
+		return &cp, true
+	case *Primitive:
+		cp := *t
+		return &cp, true
+	case *Score:
+		cp := *t
+		return &cp, true
+	case *Selector:
+		cp := *t
+		return &cp, true
+	case *NBT:
+		cp := *t
+		return &cp, true
+	case *Object:
+		cp := *t
+		return &cp, true
+	case *Keybind:
+		cp := *t
+		return &cp, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,100 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+func walkTree() *Text {
+	return &Text{
+		Content: "Hello ",
+		S:       Style{Color: color.Gold},
+		Extra: []Component{
+			&Translation{
+				Key:  "greeting",
+				S:    Style{Bold: True},
+				With: []Component{&Text{Content: "Steve", S: Style{Color: color.Red}}},
+			},
+			&Text{Content: "!"},
+		},
+	}
+}
+
+func TestVisit(t *testing.T) {
+	root := walkTree()
+
+	var visited []string
+	complete := Visit(root, func(n *Node) VisitResult {
+		switch c := n.Component.(type) {
+		case *Text:
+			visited = append(visited, c.Content)
+		case *Translation:
+			visited = append(visited, c.Key)
+		}
+		switch n.Depth {
+		case 0:
+			require.Nil(t, n.Parent)
+		default:
+			require.Equal(t, n.Component, n.Parent.Component.Children()[n.Index])
+		}
+		if n.Depth == 2 {
+			require.Equal(t, Style{Color: color.Red, Bold: True}, n.Style)
+		}
+		return VisitContinue
+	})
+	require.True(t, complete)
+	require.Equal(t, []string{"Hello ", "greeting", "Steve", "!"}, visited)
+
+	visited = nil
+	complete = Visit(root, func(n *Node) VisitResult {
+		if tr, ok := n.Component.(*Translation); ok {
+			visited = append(visited, tr.Key)
+			return VisitSkipChildren
+		}
+		visited = append(visited, n.Component.(*Text).Content)
+		return VisitContinue
+	})
+	require.True(t, complete)
+	require.Equal(t, []string{"Hello ", "greeting", "!"}, visited)
+
+	visited = nil
+	complete = Visit(root, func(n *Node) VisitResult {
+		visited = append(visited, "x")
+		if _, ok := n.Component.(*Translation); ok {
+			return VisitStop
+		}
+		return VisitContinue
+	})
+	require.False(t, complete)
+	require.Len(t, visited, 2)
+}
+
+func TestTransform(t *testing.T) {
+	root := walkTree()
+	transformed := Transform(root, func(c Component) Component {
+		if tx, ok := c.(*Text); ok {
+			if tx.Content == "!" {
+				return nil
+			}
+			tx.Content = strings.ToUpper(tx.Content)
+		}
+		return c
+	})
+
+	require.Equal(t, walkTree(), root, "original tree must not be modified")
+	require.Equal(t, &Text{
+		Content: "HELLO ",
+		S:       Style{Color: color.Gold},
+		Extra: []Component{
+			&Translation{
+				Key:  "greeting",
+				S:    Style{Bold: True},
+				With: []Component{&Text{Content: "STEVE", S: Style{Color: color.Red}}},
+			},
+		},
+	}, transformed)
+}
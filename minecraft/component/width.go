@@ -0,0 +1,149 @@
+package component
+
+import (
+	"strings"
+	"unicode"
+
+	"go.minekube.com/common/minecraft/key"
+)
+
+// defaultAdvances are the glyph advances in pixels of the printable ASCII
+// characters of the vanilla default font, including the 1 pixel spacing.
+var defaultAdvances = func() (a [128]int) {
+	for r := ' '; r < 0x7f; r++ {
+		a[r] = 6
+	}
+	set := func(advance int, chars string) {
+		for _, r := range chars {
+			a[r] = advance
+		}
+	}
+	set(2, "!',.:;i|")
+	set(3, "`l")
+	set(4, " It[]")
+	set(5, "\"()*<>fk{}")
+	set(7, "@~")
+	return
+}()
+
+// uniformAdvances approximate the glyph advances of the printable ASCII
+// characters of the vanilla uniform font.
+var uniformAdvances = func() (a [128]int) {
+	for r := ' '; r < 0x7f; r++ {
+		a[r] = 5
+	}
+	for _, r := range "!',.:;i|" {
+		a[r] = 2
+	}
+	a[' '] = 4
+	return
+}()
+
+// GlyphWidth returns the advance of the rune in pixels in the vanilla font, excluding the bold offset.
+// Nil and unknown fonts use the default font.
+//
+// The advances of the default font are exact for ASCII, other characters are approximated:
+// wide characters (e.g. CJK) advance 9 pixels, combining marks and control characters 0 pixels
+// and all other characters 6 pixels.
+// The uniform, alt and illageralt fonts are approximated as well.
+func GlyphWidth(r rune, font key.Key) int {
+	switch {
+	case r < ' ' || r == 0x7f || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		return 0
+	case isWide(r):
+		return 9
+	}
+	switch fontName(font) {
+	case UniformFont.String():
+		if r < 0x80 {
+			return uniformAdvances[r]
+		}
+		return 5
+	case AltFont.String(), IllagerAltFont.String():
+		if r == ' ' {
+			return 4
+		}
+		return 6
+	default:
+		if r < 0x80 {
+			return defaultAdvances[r]
+		}
+		return 6
+	}
+}
+
+func fontName(font key.Key) string {
+	if font == nil {
+		return ""
+	}
+	return font.String()
+}
+
+// isWide reports whether r is an East Asian wide or fullwidth character.
+func isWide(r rune) bool {
+	return r >= 0x1100 && (r <= 0x115f ||
+		(r >= 0x2e80 && r <= 0xa4cf && r != 0x303f) ||
+		(r >= 0xac00 && r <= 0xd7a3) ||
+		(r >= 0xf900 && r <= 0xfaff) ||
+		(r >= 0xfe30 && r <= 0xfe4f) ||
+		(r >= 0xff00 && r <= 0xff60) ||
+		(r >= 0xffe0 && r <= 0xffe6) ||
+		(r >= 0x20000 && r <= 0x3fffd))
+}
+
+// TextWidth returns the width in pixels of a single line of text rendered with the effective style.
+// Bold adds one pixel to every glyph except spaces.
+func TextWidth(text string, effective *Style) int {
+	var (
+		font  key.Key
+		bold  bool
+		width int
+	)
+	if effective != nil {
+		font, bold = effective.Font, effective.Bold == True
+	}
+	for _, r := range text {
+		width += advance(r, font, bold)
+	}
+	return width
+}
+
+// advance returns the advance of the rune in pixels including the bold offset.
+func advance(r rune, font key.Key, bold bool) int {
+	w := GlyphWidth(r, font)
+	if bold && w != 0 && r != ' ' {
+		w++
+	}
+	return w
+}
+
+// Width returns the width in pixels of the component tree rooted at c as rendered by the client,
+// that is the width of its widest line. Unknown component types are ignored.
+// Use Flattener.Width to render components differently.
+func Width(c Component) int {
+	w, _ := (&Flattener{Unknown: flattenNothing}).Width(c)
+	return w
+}
+
+// Width returns the width in pixels of the component tree rooted at c rendered by the Flattener,
+// that is the width of its widest line.
+func (f *Flattener) Width(c Component) (int, error) {
+	runs, err := f.Runs(c)
+	if err != nil {
+		return 0, err
+	}
+	var max, line int
+	for _, run := range runs {
+		lines := strings.Split(run.Text, "\n")
+		for i, text := range lines {
+			if i != 0 {
+				line = 0
+			}
+			line += TextWidth(text, &run.Style)
+			if line > max {
+				max = line
+			}
+		}
+	}
+	return max, nil
+}
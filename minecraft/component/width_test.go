@@ -0,0 +1,42 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+)
+
+func TestGlyphWidth(t *testing.T) {
+	for r, w := range map[rune]int{
+		'a': 6, 'i': 2, 'l': 3, 't': 4, 'f': 5, 'I': 4, ' ': 4, '@': 7, '.': 2, 'é': 6, '界': 9, '́': 0, '\n': 0,
+	} {
+		require.Equal(t, w, GlyphWidth(r, nil), "%q", r)
+		require.Equal(t, w, GlyphWidth(r, DefaultFont), "%q", r)
+	}
+	require.Equal(t, 5, GlyphWidth('a', UniformFont))
+	require.Equal(t, 6, GlyphWidth('i', AltFont))
+}
+
+func TestTextWidth(t *testing.T) {
+	require.Equal(t, 0, TextWidth("", nil))
+	require.Equal(t, 6+6+3+3+6, TextWidth("Hello", nil))
+	require.Equal(t, 6+6+3+3+6+5, TextWidth("Hello", &Style{Bold: True}))
+	require.Equal(t, 2*7+4, TextWidth("a a", &Style{Bold: True}))
+	require.Equal(t, 3*5, TextWidth("abc", &Style{Font: UniformFont}))
+}
+
+func TestWidth(t *testing.T) {
+	c := &Text{
+		Content: "Hi ",
+		S:       Style{Color: color.Gold},
+		Extra: []Component{
+			&Text{Content: "there", S: Style{Bold: True}},
+			&Keybind{Key: "key.jump"},
+		},
+	}
+	require.Equal(t, (6+2+4)+(5+7+7+7+7)+(6+6+6+6+6), Width(c))
+	require.Equal(t, 6+6+6, Width(&Text{Content: "ab\nabc\n"}))
+	require.Equal(t, 0, Width(&Text{}))
+}
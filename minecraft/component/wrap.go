@@ -0,0 +1,164 @@
+package component
+
+// Wrapper splits components into lines fitting a width.
+//
+// Lines are broken at spaces, which are dropped at the break, and words that
+// do not fit on a line on their own are broken at the last fitting character.
+// Newlines in the rendered text always start a new line.
+type Wrapper struct {
+	// MaxWidth is the maximum width of a line in pixels as measured by TextWidth.
+	// Zero means no limit.
+	MaxWidth int
+	// MaxChars is the maximum number of characters of a line.
+	// Zero means no limit.
+	MaxChars int
+	// The optional Flattener to render components with.
+	Flattener *Flattener
+}
+
+// Wrap splits the component tree rooted at c into lines.
+// Every line is a standalone component whose texts carry their effective style,
+// including click and hover events, relative to c.
+func (w *Wrapper) Wrap(c Component) ([]Component, error) {
+	f := w.Flattener
+	if f == nil {
+		f = &Flattener{}
+	}
+	runs, err := f.Runs(c)
+	if err != nil {
+		return nil, err
+	}
+	lines := w.wrap(runs)
+	cs := make([]Component, len(lines))
+	for i, line := range lines {
+		cs[i] = line.component()
+	}
+	return cs, nil
+}
+
+// glyph is a rune with its style.
+type glyph struct {
+	r     rune
+	style *Style
+}
+
+func (g glyph) width() int {
+	return advance(g.r, g.style.Font, g.style.Bold == True)
+}
+
+// line is a wrapped line.
+type line []glyph
+
+func (l line) width() (w int) {
+	for _, g := range l {
+		w += g.width()
+	}
+	return w
+}
+
+// component returns the line as a component.
+func (l line) component() Component {
+	var texts []Component
+	for start := 0; start < len(l); {
+		end := start
+		var content []rune
+		for ; end < len(l) && l[end].style == l[start].style; end++ {
+			content = append(content, l[end].r)
+		}
+		texts = append(texts, &Text{Content: string(content), S: l[start].style.Clone()})
+		start = end
+	}
+	switch len(texts) {
+	case 0:
+		return &Text{}
+	case 1:
+		return texts[0]
+	default:
+		return &Text{Extra: texts}
+	}
+}
+
+func (w *Wrapper) fits(width, chars int) bool {
+	return (w.MaxWidth <= 0 || width <= w.MaxWidth) && (w.MaxChars <= 0 || chars <= w.MaxChars)
+}
+
+// wrap splits the runs into lines.
+func (w *Wrapper) wrap(runs []Run) []line {
+	var glyphs []glyph
+	for i := range runs {
+		for _, r := range runs[i].Text {
+			glyphs = append(glyphs, glyph{r: r, style: &runs[i].Style})
+		}
+	}
+
+	var (
+		lines   []line
+		current line
+		width   int
+		wrapped bool // whether the current line was started by wrapping
+	)
+	breakLine := func(wrap bool) {
+		lines = append(lines, current)
+		current, width, wrapped = nil, 0, wrap
+	}
+	// add adds the glyphs to the current line, breaking them where they do not fit
+	add := func(gs []glyph) {
+		for _, g := range gs {
+			gw := g.width()
+			if len(current) != 0 && !w.fits(width+gw, len(current)+1) {
+				breakLine(true)
+			}
+			current = append(current, g)
+			width += gw
+		}
+	}
+	wordEnd := func(i int) int {
+		for i < len(glyphs) && glyphs[i].r != ' ' && glyphs[i].r != '\n' {
+			i++
+		}
+		return i
+	}
+
+	for i := 0; i < len(glyphs); {
+		switch glyphs[i].r {
+		case '\n':
+			breakLine(false)
+			i++
+		case ' ':
+			end := i
+			for end < len(glyphs) && glyphs[end].r == ' ' {
+				end++
+			}
+			if len(current) == 0 && wrapped {
+				// drop spaces at the start of a wrapped line
+				i = end
+				continue
+			}
+			// the spaces are kept with the following word if both fit
+			chunk := glyphs[i:wordEnd(end)]
+			chunkWidth := line(chunk).width()
+			switch {
+			case w.fits(width+chunkWidth, len(current)+len(chunk)):
+				current = append(current, chunk...)
+				width += chunkWidth
+				i += len(chunk)
+			case len(current) == 0:
+				add(chunk)
+				i += len(chunk)
+			default:
+				breakLine(true)
+				i = end
+			}
+		default:
+			word := glyphs[i:wordEnd(i)]
+			if len(current) != 0 && !w.fits(width+line(word).width(), len(current)+len(word)) &&
+				w.fits(line(word).width(), len(word)) {
+				breakLine(true)
+			}
+			add(word)
+			i += len(word)
+		}
+	}
+	lines = append(lines, current)
+	return lines
+}
@@ -0,0 +1,63 @@
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+)
+
+func wrapPlain(t *testing.T, w *Wrapper, c Component) []string {
+	lines, err := w.Wrap(c)
+	require.NoError(t, err)
+	var texts []string
+	for _, line := range lines {
+		b := new(strings.Builder)
+		require.NoError(t, (&codec.Plain{}).Marshal(b, line))
+		texts = append(texts, b.String())
+	}
+	return texts
+}
+
+func TestWrapper_Wrap(t *testing.T) {
+	w := &Wrapper{MaxChars: 10}
+	require.Equal(t, []string{"The quick", "brown fox", "jumps over", "the lazy", "dog"},
+		wrapPlain(t, w, &Text{Content: "The quick brown fox jumps over the lazy dog"}))
+	require.Equal(t, []string{"Supercalif", "ragilistic", "a word"},
+		wrapPlain(t, w, &Text{Content: "Supercalifragilistic a word"}))
+	require.Equal(t, []string{"one", "", "  indented", "two"},
+		wrapPlain(t, w, &Text{Content: "one\n\n  indented two"}))
+	require.Equal(t, []string{""}, wrapPlain(t, w, &Text{}))
+
+	// pixel width: "aaaa" is 24 pixels, "ii" 4 pixels and spaces 4 pixels
+	w = &Wrapper{MaxWidth: 32}
+	require.Equal(t, []string{"aaaa ii", "aaaa"}, wrapPlain(t, w, &Text{Content: "aaaa ii aaaa"}))
+	w = &Wrapper{MaxWidth: 31}
+	require.Equal(t, []string{"aaaa", "ii", "aaaa"}, wrapPlain(t, w, &Text{Content: "aaaa ii aaaa"}))
+}
+
+func TestWrapper_Wrap_styles(t *testing.T) {
+	click := RunCommand("/spawn")
+	c := &Text{
+		Content: "Go to ",
+		S:       Style{Color: color.Gray},
+		Extra: []Component{
+			&Text{Content: "the spawn now", S: Style{Bold: True, ClickEvent: click}},
+			&Text{Content: "!"},
+		},
+	}
+	lines, err := (&Wrapper{MaxChars: 10}).Wrap(c)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "Go to ", S: Style{Color: color.Gray}},
+		&Text{Content: "the", S: Style{Color: color.Gray, Bold: True, ClickEvent: click}},
+	}}, lines[0]))
+	require.True(t, Equal(&Text{Extra: []Component{
+		&Text{Content: "spawn now", S: Style{Color: color.Gray, Bold: True, ClickEvent: click}},
+		&Text{Content: "!", S: Style{Color: color.Gray}},
+	}}, lines[1]))
+}